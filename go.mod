@@ -6,64 +6,67 @@ require (
 	github.com/ProtonMail/go-crypto v0.0.0-20230717121422-5aa5874ade95
 	github.com/YakDriver/regexache v0.7.0
 	github.com/aws/aws-sdk-go v1.44.328
-	github.com/aws/aws-sdk-go-v2 v1.21.0
-	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.13.10
-	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.20.5
-	github.com/aws/aws-sdk-go-v2/service/account v1.11.5
-	github.com/aws/aws-sdk-go-v2/service/acm v1.18.5
-	github.com/aws/aws-sdk-go-v2/service/appconfig v1.18.5
-	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.26.5
-	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.3.5
-	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.12.5
-	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.23.5
-	github.com/aws/aws-sdk-go-v2/service/codecatalyst v1.5.5
-	github.com/aws/aws-sdk-go-v2/service/codestarnotifications v1.15.5
-	github.com/aws/aws-sdk-go-v2/service/comprehend v1.25.5
-	github.com/aws/aws-sdk-go-v2/service/computeoptimizer v1.25.5
-	github.com/aws/aws-sdk-go-v2/service/directoryservice v1.18.5
-	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.2.5
-	github.com/aws/aws-sdk-go-v2/service/ec2 v1.114.0
-	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.10.5
-	github.com/aws/aws-sdk-go-v2/service/finspace v1.12.0
-	github.com/aws/aws-sdk-go-v2/service/fis v1.15.5
-	github.com/aws/aws-sdk-go-v2/service/glacier v1.15.5
-	github.com/aws/aws-sdk-go-v2/service/healthlake v1.17.5
-	github.com/aws/aws-sdk-go-v2/service/identitystore v1.17.6
-	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.16.6
-	github.com/aws/aws-sdk-go-v2/service/internetmonitor v1.5.4
-	github.com/aws/aws-sdk-go-v2/service/ivschat v1.5.5
-	github.com/aws/aws-sdk-go-v2/service/kafka v1.22.5
-	github.com/aws/aws-sdk-go-v2/service/kendra v1.42.5
-	github.com/aws/aws-sdk-go-v2/service/keyspaces v1.4.5
-	github.com/aws/aws-sdk-go-v2/service/lambda v1.39.5
-	github.com/aws/aws-sdk-go-v2/service/lightsail v1.28.5
-	github.com/aws/aws-sdk-go-v2/service/medialive v1.34.4
-	github.com/aws/aws-sdk-go-v2/service/mediapackage v1.23.3
-	github.com/aws/aws-sdk-go-v2/service/oam v1.2.5
-	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.4.5
-	github.com/aws/aws-sdk-go-v2/service/pipes v1.3.5
-	github.com/aws/aws-sdk-go-v2/service/pricing v1.21.6
-	github.com/aws/aws-sdk-go-v2/service/qldb v1.16.5
-	github.com/aws/aws-sdk-go-v2/service/rbin v1.9.5
-	github.com/aws/aws-sdk-go-v2/service/rds v1.51.0
-	github.com/aws/aws-sdk-go-v2/service/resourceexplorer2 v1.3.5
-	github.com/aws/aws-sdk-go-v2/service/rolesanywhere v1.3.5
-	github.com/aws/aws-sdk-go-v2/service/route53domains v1.17.3
-	github.com/aws/aws-sdk-go-v2/service/s3control v1.32.5
-	github.com/aws/aws-sdk-go-v2/service/scheduler v1.2.5
-	github.com/aws/aws-sdk-go-v2/service/securitylake v1.6.5
-	github.com/aws/aws-sdk-go-v2/service/sesv2 v1.19.5
-	github.com/aws/aws-sdk-go-v2/service/signer v1.16.5
-	github.com/aws/aws-sdk-go-v2/service/ssm v1.37.5
-	github.com/aws/aws-sdk-go-v2/service/ssmcontacts v1.16.5
-	github.com/aws/aws-sdk-go-v2/service/ssmincidents v1.22.5
-	github.com/aws/aws-sdk-go-v2/service/swf v1.17.3
-	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.18.5
-	github.com/aws/aws-sdk-go-v2/service/transcribe v1.28.5
-	github.com/aws/aws-sdk-go-v2/service/verifiedpermissions v1.1.5
-	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.1.7
-	github.com/aws/aws-sdk-go-v2/service/workspaces v1.29.5
-	github.com/aws/aws-sdk-go-v2/service/xray v1.17.5
+	github.com/aws/aws-sdk-go-v2 v1.23.1
+	github.com/aws/aws-sdk-go-v2/credentials v1.16.4
+	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.14.5
+	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.24.0
+	github.com/aws/aws-sdk-go-v2/service/account v1.13.3
+	github.com/aws/aws-sdk-go-v2/service/acm v1.21.3
+	github.com/aws/aws-sdk-go-v2/service/appconfig v1.25.3
+	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.29.3
+	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.7.2
+	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.14.4
+	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.28.0
+	github.com/aws/aws-sdk-go-v2/service/codecatalyst v1.9.1
+	github.com/aws/aws-sdk-go-v2/service/codestarnotifications v1.19.3
+	github.com/aws/aws-sdk-go-v2/service/comprehend v1.28.2
+	github.com/aws/aws-sdk-go-v2/service/computeoptimizer v1.30.0
+	github.com/aws/aws-sdk-go-v2/service/directoryservice v1.21.3
+	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.5.3
+	github.com/aws/aws-sdk-go-v2/service/ec2 v1.137.1
+	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.13.4
+	github.com/aws/aws-sdk-go-v2/service/finspace v1.17.1
+	github.com/aws/aws-sdk-go-v2/service/fis v1.20.0
+	github.com/aws/aws-sdk-go-v2/service/glacier v1.18.3
+	github.com/aws/aws-sdk-go-v2/service/healthlake v1.19.3
+	github.com/aws/aws-sdk-go-v2/service/identitystore v1.20.3
+	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.19.3
+	github.com/aws/aws-sdk-go-v2/service/internetmonitor v1.9.1
+	github.com/aws/aws-sdk-go-v2/service/ivschat v1.9.1
+	github.com/aws/aws-sdk-go-v2/service/kafka v1.27.1
+	github.com/aws/aws-sdk-go-v2/service/kendra v1.46.3
+	github.com/aws/aws-sdk-go-v2/service/keyspaces v1.6.3
+	github.com/aws/aws-sdk-go-v2/service/lambda v1.48.1
+	github.com/aws/aws-sdk-go-v2/service/lightsail v1.31.3
+	github.com/aws/aws-sdk-go-v2/service/medialive v1.41.1
+	github.com/aws/aws-sdk-go-v2/service/mediapackage v1.27.1
+	github.com/aws/aws-sdk-go-v2/service/oam v1.6.3
+	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.8.3
+	github.com/aws/aws-sdk-go-v2/service/pipes v1.8.1
+	github.com/aws/aws-sdk-go-v2/service/pricing v1.23.3
+	github.com/aws/aws-sdk-go-v2/service/qldb v1.18.3
+	github.com/aws/aws-sdk-go-v2/service/rbin v1.12.3
+	github.com/aws/aws-sdk-go-v2/service/rds v1.63.2
+	github.com/aws/aws-sdk-go-v2/service/resourceexplorer2 v1.7.2
+	github.com/aws/aws-sdk-go-v2/service/rolesanywhere v1.5.3
+	github.com/aws/aws-sdk-go-v2/service/route53domains v1.19.3
+	github.com/aws/aws-sdk-go-v2/service/s3control v1.39.0
+	github.com/aws/aws-sdk-go-v2/service/scheduler v1.5.3
+	github.com/aws/aws-sdk-go-v2/service/securitylake v1.9.3
+	github.com/aws/aws-sdk-go-v2/service/sesv2 v1.23.3
+	github.com/aws/aws-sdk-go-v2/service/signer v1.18.4
+	github.com/aws/aws-sdk-go-v2/service/ssm v1.43.1
+	github.com/aws/aws-sdk-go-v2/service/ssmcontacts v1.19.3
+	github.com/aws/aws-sdk-go-v2/service/ssmincidents v1.26.1
+	github.com/aws/aws-sdk-go-v2/service/sts v1.25.4
+	github.com/aws/aws-sdk-go-v2/service/swf v1.19.3
+	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.22.3
+	github.com/aws/aws-sdk-go-v2/service/transcribe v1.32.0
+	github.com/aws/aws-sdk-go-v2/service/verifiedpermissions v1.6.1
+	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.4.3
+	github.com/aws/aws-sdk-go-v2/service/workspaces v1.34.0
+	github.com/aws/aws-sdk-go-v2/service/xray v1.22.3
+	github.com/aws/smithy-go v1.17.0
 	github.com/beevik/etree v1.2.0
 	github.com/google/go-cmp v0.5.9
 	github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go v0.21.0
@@ -93,6 +96,11 @@ require (
 	github.com/mitchellh/mapstructure v1.5.0
 	github.com/pquerna/otp v1.4.0
 	github.com/shopspring/decimal v1.3.1
+	go.opentelemetry.io/otel v1.16.0
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp v1.16.0
+	go.opentelemetry.io/otel/exporters/stdout/stdouttrace v1.16.0
+	go.opentelemetry.io/otel/sdk v1.16.0
+	go.opentelemetry.io/otel/trace v1.16.0
 	golang.org/x/crypto v0.12.0
 	golang.org/x/exp v0.0.0-20230811145659-89c5cff77bcb
 	golang.org/x/tools v0.12.0
@@ -108,31 +116,33 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v13 v13.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.4.13 // indirect
-	github.com/aws/aws-sdk-go-v2/config v1.18.33 // indirect
-	github.com/aws/aws-sdk-go-v2/credentials v1.13.32 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.1.41 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.4.35 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/ini v1.3.39 // indirect
-	github.com/aws/aws-sdk-go-v2/service/iam v1.22.5 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.7.35 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.9.35 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.15.4 // indirect
-	github.com/aws/aws-sdk-go-v2/service/sso v1.13.5 // indirect
-	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.15.5 // indirect
-	github.com/aws/aws-sdk-go-v2/service/sts v1.21.5 // indirect
-	github.com/aws/smithy-go v1.14.2 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.5.1 // indirect
+	github.com/aws/aws-sdk-go-v2/config v1.25.5 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.2.4 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.5.4 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/ini v1.7.1 // indirect
+	github.com/aws/aws-sdk-go-v2/service/iam v1.27.3 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.10.1 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.8.4 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.10.4 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.16.4 // indirect
+	github.com/aws/aws-sdk-go-v2/service/sso v1.17.3 // indirect
+	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.20.1 // indirect
 	github.com/bgentry/speakeasy v0.1.0 // indirect
 	github.com/boombuler/barcode v1.0.1 // indirect
 	github.com/bufbuild/protocompile v0.6.0 // indirect
+	github.com/cenkalti/backoff/v4 v4.2.1 // indirect
 	github.com/cloudflare/circl v1.3.3 // indirect
 	github.com/evanphx/json-patch v0.5.2 // indirect
 	github.com/fatih/color v1.15.0 // indirect
 	github.com/frankban/quicktest v1.14.6 // indirect
 	github.com/go-git/go-git/v5 v5.8.1 // indirect
+	github.com/go-logr/logr v1.2.4 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/go-test/deep v1.1.0 // indirect
 	github.com/golang/protobuf v1.5.3 // indirect
 	github.com/google/uuid v1.3.0 // indirect
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.7.0 // indirect
 	github.com/hashicorp/errwrap v1.1.0 // indirect
 	github.com/hashicorp/go-checkpoint v0.5.0 // indirect
 	github.com/hashicorp/go-hclog v1.5.0 // indirect
@@ -163,13 +173,17 @@ require (
 	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415 // indirect
 	github.com/xeipuuv/gojsonschema v1.2.0 // indirect
 	github.com/zclconf/go-cty v1.13.2 // indirect
-	go.opentelemetry.io/otel v1.16.0 // indirect
-	go.opentelemetry.io/otel/trace v1.16.0 // indirect
+	go.opentelemetry.io/otel/exporters/otlp/internal/retry v1.16.0 // indirect
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace v1.16.0 // indirect
+	go.opentelemetry.io/otel/metric v1.16.0 // indirect
+	go.opentelemetry.io/proto/otlp v0.19.0 // indirect
 	golang.org/x/mod v0.12.0 // indirect
 	golang.org/x/net v0.14.0 // indirect
 	golang.org/x/sys v0.11.0 // indirect
 	golang.org/x/text v0.12.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
+	google.golang.org/genproto v0.0.0-20230803162519-f966b187b2e5 // indirect
+	google.golang.org/genproto/googleapis/api v0.0.0-20230726155614-23370e0ffb3e // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20230807174057-1744710a1577 // indirect
 	google.golang.org/grpc v1.57.0 // indirect
 	google.golang.org/protobuf v1.31.0 // indirect
@@ -6,4 +6,11 @@ package acctest
 // Exports for use in tests only.
 var (
 	CloseVCRRecorder = closeVCRRecorder
+	NewVCRRecorder   = newVCRRecorder
+	VCRAWSConfig     = vcrAWSConfig
+	VCRMatcher       = vcrMatcher
+	VCRRedact        = vcrRedact
+
+	VCRRestoreSecrets    = vcrRestoreSecrets
+	VCRSecretPlaceholder = vcrSecretPlaceholder
 )
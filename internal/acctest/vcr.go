@@ -9,10 +9,13 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -20,6 +23,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
@@ -164,129 +170,307 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
 
 		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
+		r, err := newVCRRecorder(ctx, path, vcrMode, httpClient.Transport)
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
+		// Use the wrapped HTTP Client for AWS APIs.
+		// As the HTTP client is used in the provider's ConfigureContextFunc
+		// we must do this setup before calling the ConfigureContextFunc.
+		httpClient.Transport = r
+		if v, ok := provider.Meta().(*conns.AWSClient); ok {
+			meta = v
+		} else {
+			meta = new(conns.AWSClient)
+		}
+		meta.SetHTTPClient(httpClient)
+		provider.SetMeta(meta)
+
+		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
+			return nil, append(diags, ds...)
+		} else {
+			meta = v.(*conns.AWSClient)
+		}
+
+		// Don't retry requests if a recorded interaction isn't found.
+		// AWS SDK for Go v1 API clients copy their handlers from the Session when they are created.
+		meta.Session.Handlers.AfterRetry.PushFront(func(r *request.Request) {
+			// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
+			if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
+				r.Retryable = aws.Bool(false)
+			}
+		})
+		meta.UpdateAWSConfig(vcrAWSConfig(httpClient))
+
+		providerMetas[testName] = meta
+
+		return meta, diags
+	}
+}
+
+// newVCRRecorder returns a VCR recorder for the cassette at the specified path.
+// Interactions not found in the cassette are passed to the real transport when recording.
+func newVCRRecorder(ctx context.Context, path string, mode recorder.Mode, realTransport http.RoundTripper) (*recorder.Recorder, error) {
+	r, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:  path,
+		Mode:          mode,
+		RealTransport: realTransport,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove sensitive HTTP headers and values.
+	r.AddHook(func(i *cassette.Interaction) error {
+		delete(i.Request.Headers, "Authorization")
+		delete(i.Request.Headers, "X-Amz-Security-Token")
+
+		return vcrRedactInteraction(i)
+	}, recorder.AfterCaptureHook)
 
-			return nil
-		}, recorder.AfterCaptureHook)
+	// Responses are returned with secret values restored and redacted again when saved.
+	r.AddHook(vcrRestoreInteractionSecrets, recorder.BeforeResponseReplayHook)
+	r.AddHook(vcrRedactInteraction, recorder.BeforeSaveHook)
 
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
+	// Defines how VCR will match requests to responses.
+	r.SetMatcher(vcrMatcher(ctx))
+
+	return r, nil
+}
+
+// vcrVolatileFields are the names of request fields whose values differ between recording and replaying, e.g. idempotency tokens.
+var vcrVolatileFields = []string{
+	"CallerReference",
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+}
+
+// vcrVolatileXMLRegexp matches XML elements for volatile fields.
+var vcrVolatileXMLRegexp = regexache.MustCompile(`<(?i:` + strings.Join(vcrVolatileFields, "|") + `)>[^<]*</(?i:` + strings.Join(vcrVolatileFields, "|") + `)>`)
+
+// vcrMatcher returns a function that matches requests to recorded interactions.
+// Requests are redacted in the same way as recorded interactions before matching.
+func vcrMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method || !vcrMatchURL(r.URL, i.URL) {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := vcrRedact(b.String())
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			return false
+		}
+
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		switch mediaType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			var requestJson, cassetteJson interface{}
+
+			if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+					"error": err,
+				})
 				return false
 			}
 
-			if r.Body == nil {
+			return reflect.DeepEqual(vcrRemoveVolatileJSONFields(requestJson), vcrRemoveVolatileJSONFields(cassetteJson))
+
+		case "application/xml", "text/xml":
+			// Volatile fields are removed before comparing.
+			if vcrVolatileXMLRegexp.ReplaceAllString(body, "") == vcrVolatileXMLRegexp.ReplaceAllString(i.Body, "") {
 				return true
 			}
 
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+			// XML might be the same, but reordered. Try parsing and comparing.
+			var requestXml, cassetteXml interface{}
+
+			if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
 					"error": err,
 				})
 				return false
 			}
 
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
+			if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+			return reflect.DeepEqual(requestXml, cassetteXml)
 
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+		case "application/x-www-form-urlencoded":
+			// AWS Query protocol parameters might be the same, but reordered. Try parsing and comparing.
+			requestForm, err := url.ParseQuery(body)
 
-				return reflect.DeepEqual(requestJson, cassetteJson)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse request form", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
 
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
+			cassetteForm, err := url.ParseQuery(i.Body)
 
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse cassette form", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
 
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+			return reflect.DeepEqual(vcrRemoveVolatileFormFields(requestForm), vcrRemoveVolatileFormFields(cassetteForm))
+		}
+
+		return false
+	}
+}
 
-				return reflect.DeepEqual(requestXml, cassetteXml)
+// vcrMatchURL returns whether a request URL matches a recorded URL, ignoring the order of query string parameters and volatile fields.
+func vcrMatchURL(u *url.URL, recorded string) bool {
+	if vcrRedact(u.String()) == recorded {
+		return true
+	}
+
+	v, err := url.Parse(recorded)
+
+	if err != nil {
+		return false
+	}
+
+	w, err := url.Parse(vcrRedact(u.String()))
+
+	if err != nil {
+		return false
+	}
+
+	if w.Scheme != v.Scheme || w.Host != v.Host || w.Path != v.Path {
+		return false
+	}
+
+	return reflect.DeepEqual(vcrRemoveVolatileFormFields(w.Query()), vcrRemoveVolatileFormFields(v.Query()))
+}
+
+// vcrRemoveVolatileJSONFields removes volatile fields from a decoded JSON value.
+func vcrRemoveVolatileJSONFields(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if vcrIsVolatileField(k) {
+				delete(v, k)
+			} else {
+				v[k] = vcrRemoveVolatileJSONFields(e)
 			}
+		}
+	case []interface{}:
+		for k, e := range v {
+			v[k] = vcrRemoveVolatileJSONFields(e)
+		}
+	}
 
-			return false
-		})
+	return v
+}
 
-		// Use the wrapped HTTP Client for AWS APIs.
-		// As the HTTP client is used in the provider's ConfigureContextFunc
-		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
-		if v, ok := provider.Meta().(*conns.AWSClient); ok {
-			meta = v
-		} else {
-			meta = new(conns.AWSClient)
+// vcrRemoveVolatileFormFields removes volatile fields, including nested AWS Query protocol members, from form values.
+func vcrRemoveVolatileFormFields(v url.Values) url.Values {
+	for k := range v {
+		name := k
+		if n := strings.LastIndex(k, "."); n >= 0 {
+			name = k[n+1:]
 		}
-		meta.SetHTTPClient(httpClient)
-		provider.SetMeta(meta)
 
-		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
-			return nil, append(diags, ds...)
-		} else {
-			meta = v.(*conns.AWSClient)
+		if vcrIsVolatileField(name) {
+			delete(v, k)
 		}
+	}
+
+	return v
+}
+
+// vcrIsVolatileField returns whether the named field is volatile.
+// Protocols differ in field name case, e.g. `ClientToken` and `clientToken`.
+func vcrIsVolatileField(name string) bool {
+	for _, v := range vcrVolatileFields {
+		if strings.EqualFold(name, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// vcrAWSConfig returns a function that configures AWS SDK for Go v2 API clients for use with VCR.
+func vcrAWSConfig(httpClient *http.Client) func(*aws_sdkv2.Config) {
+	return func(cfg *aws_sdkv2.Config) {
+		cfg.HTTPClient = httpClient
 
 		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		meta.LogsConn(ctx).Handlers.AfterRetry.PushFront(func(r *request.Request) {
-			// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-			if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-				r.Retryable = aws.Bool(false)
+		newRetryer := cfg.Retryer
+		cfg.Retryer = func() aws_sdkv2.Retryer {
+			var r aws_sdkv2.Retryer
+			if newRetryer != nil {
+				r = newRetryer()
+			} else {
+				r = retry.NewStandard()
 			}
-		})
 
-		providerMetas[testName] = meta
+			return vcrRetryer{Retryer: r}
+		}
+	}
+}
 
-		return meta, diags
+// vcrRetryer is an AWS SDK for Go v2 retryer that doesn't retry requests whose recorded interaction isn't found.
+// The AWS SDK treats the VCR recorder's error as a retryable connection error.
+type vcrRetryer struct {
+	aws_sdkv2.Retryer
+}
+
+func (r vcrRetryer) IsErrorRetryable(err error) bool {
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		return false
 	}
+
+	return r.Retryer.IsErrorRetryable(err)
+}
+
+func (r vcrRetryer) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	if v, ok := r.Retryer.(aws_sdkv2.RetryerV2); ok {
+		return v.GetAttemptToken(ctx)
+	}
+
+	return r.Retryer.GetInitialToken(), nil
 }
 
 // vcrRandomnessSource returns a rand.Source for VCR testing.
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"html"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	// vcrRedactedAccountID replaces AWS account IDs in VCR cassettes.
+	vcrRedactedAccountID = "123456789012"
+	// vcrRedactedValue replaces other sensitive values in VCR cassettes, and prefixes secret placeholders.
+	vcrRedactedValue = "REDACTED"
+)
+
+// VCRRedactor redacts sensitive values from a recorded HTTP request or response body or request URL.
+// Redactors must be deterministic so that live requests can be matched against redacted recordings.
+type VCRRedactor func(string) string
+
+var (
+	vcrRedactorsLock sync.Mutex
+	vcrRedactors     = []VCRRedactor{
+		vcrRedactAccountIDs,
+		vcrRedactAccessKeyIDs,
+		vcrRedactPresignedURLs,
+		VCRSecretFieldRedactor(`SecretAccessKey`),
+		VCRSecretFieldRedactor(`SessionToken`),
+		VCRSecretFieldRedactor(`SecretString`),
+		VCRSecretFieldRedactor(`SecretBinary`),
+		VCRSecretFieldRedactor(`PrivateKey`),
+		VCRSecretFieldRedactor(`KeyMaterial`),
+		VCRSecretFieldRedactor(`\w*Password`),
+	}
+
+	// vcrSecrets maps secret placeholders to the values they replace.
+	vcrSecrets sync.Map
+)
+
+// AddVCRRedactors adds redactors to the pipeline applied to all recorded VCR interactions.
+func AddVCRRedactors(redactors ...VCRRedactor) {
+	vcrRedactorsLock.Lock()
+	defer vcrRedactorsLock.Unlock()
+
+	vcrRedactors = append(vcrRedactors, redactors...)
+}
+
+// vcrRedact runs the redaction pipeline over the specified value.
+func vcrRedact(s string) string {
+	vcrRedactorsLock.Lock()
+	defer vcrRedactorsLock.Unlock()
+
+	for _, redactor := range vcrRedactors {
+		s = redactor(s)
+	}
+
+	return s
+}
+
+// vcrRedactInteraction redacts sensitive values from a recorded interaction.
+func vcrRedactInteraction(i *cassette.Interaction) error {
+	i.Request.URL = vcrRedact(i.Request.URL)
+	i.Request.Body = vcrRedact(i.Request.Body)
+	vcrSetContentLength(i.Request.Headers, &i.Request.ContentLength, i.Request.Body)
+
+	if len(i.Request.Form) > 0 {
+		form, err := url.ParseQuery(vcrRedact(i.Request.Form.Encode()))
+
+		if err != nil {
+			return err
+		}
+
+		i.Request.Form = form
+	}
+
+	i.Response.Body = vcrRedact(i.Response.Body)
+	vcrSetContentLength(i.Response.Headers, &i.Response.ContentLength, i.Response.Body)
+
+	return nil
+}
+
+// vcrSetContentLength updates a recorded content length after redaction.
+// Unknown content lengths, e.g. for chunked transfer encoding, are left as is.
+func vcrSetContentLength(headers map[string][]string, contentLength *int64, body string) {
+	if *contentLength < 0 {
+		return
+	}
+
+	*contentLength = int64(len(body))
+
+	if _, ok := headers["Content-Length"]; ok {
+		headers["Content-Length"] = []string{strconv.Itoa(len(body))}
+	}
+}
+
+var (
+	// Account IDs in ARNs, which may be URL-encoded.
+	vcrARNAccountIDRegexp = regexache.MustCompile(`(arn(?::|%3A)[\w-]+(?::|%3A)[\w-]+(?::|%3A)[\w-]*(?::|%3A))\d{12}((?::|%3A))`)
+	vcrAccountIDFields    = VCRFieldRedactor(`Account|AccountId|OwnerId`, vcrRedactedAccountID)
+	// AWS access key IDs.
+	vcrAccessKeyIDRegexp = regexache.MustCompile(`\b((?:AKIA|ASIA))[A-Z0-9]{16}\b`)
+	// Signature V4 query string parameters in presigned URLs.
+	vcrPresignedURLRegexp = regexache.MustCompile(`((?i:X-Amz-(?:Credential|Security-Token|Signature))=)[^&"'<>\s\\]+`)
+)
+
+func vcrRedactAccountIDs(s string) string {
+	s = vcrARNAccountIDRegexp.ReplaceAllString(s, "${1}"+vcrRedactedAccountID+"${2}")
+
+	return vcrAccountIDFields(s)
+}
+
+func vcrRedactAccessKeyIDs(s string) string {
+	return vcrAccessKeyIDRegexp.ReplaceAllString(s, "${1}XXXXXXXXXXXXXXXX")
+}
+
+func vcrRedactPresignedURLs(s string) string {
+	return vcrPresignedURLRegexp.ReplaceAllString(s, "${1}"+vcrRedactedValue)
+}
+
+// VCRFieldRedactor returns a redactor that replaces the values of fields whose names match the specified
+// regular expression (case-insensitively) in JSON and XML documents and form-encoded parameters.
+func VCRFieldRedactor(name, replacement string) VCRRedactor {
+	return vcrFieldRedactor(name, func(string, vcrFieldFormat) string {
+		return replacement
+	})
+}
+
+// VCRSecretFieldRedactor returns a redactor that replaces the values of fields whose names match the specified
+// regular expression (case-insensitively) with placeholders derived from the values.
+// When replaying, placeholders in recorded responses for values sent in requests are replaced by the values,
+// so that replayed state matches the test configuration.
+func VCRSecretFieldRedactor(name string) VCRRedactor {
+	return vcrFieldRedactor(name, func(raw string, format vcrFieldFormat) string {
+		if vcrSecretPlaceholderRegexp.MatchString(raw) {
+			return raw
+		}
+
+		value := format.decode(raw)
+		placeholder := vcrSecretPlaceholder(value)
+		vcrSecrets.Store(placeholder, value)
+
+		return placeholder
+	})
+}
+
+// vcrFieldFormat is the encoding of a redacted field value.
+type vcrFieldFormat int
+
+const (
+	vcrFieldFormatJSON vcrFieldFormat = iota
+	vcrFieldFormatXML
+	vcrFieldFormatForm
+)
+
+// decode returns the unescaped value of a raw field value.
+func (f vcrFieldFormat) decode(raw string) string {
+	switch f {
+	case vcrFieldFormatJSON:
+		var v string
+		if err := json.Unmarshal([]byte(`"`+raw+`"`), &v); err == nil {
+			return v
+		}
+	case vcrFieldFormatXML:
+		return html.UnescapeString(raw)
+	case vcrFieldFormatForm:
+		if v, err := url.QueryUnescape(raw); err == nil {
+			return v
+		}
+	}
+
+	return raw
+}
+
+func vcrFieldRedactor(name string, replace func(string, vcrFieldFormat) string) VCRRedactor {
+	// JSON string values.
+	jsonRegexp := regexache.MustCompile(`("(?i:` + name + `)"\s*:\s*)"((?:[^"\\]|\\.)*)"`)
+	// XML element text.
+	xmlRegexp := regexache.MustCompile(`(<(?i:` + name + `)>)([^<]*)(</(?i:` + name + `)>)`)
+	// Form-encoded parameters, including AWS Query protocol members such as `Foo.1.Password`.
+	formRegexp := regexache.MustCompile(`((?:^|[&?])(?:[\w.]+\.)?(?i:` + name + `)=)([^&]*)`)
+
+	return func(s string) string {
+		s = jsonRegexp.ReplaceAllStringFunc(s, func(m string) string {
+			v := jsonRegexp.FindStringSubmatch(m)
+			return v[1] + `"` + replace(v[2], vcrFieldFormatJSON) + `"`
+		})
+		s = xmlRegexp.ReplaceAllStringFunc(s, func(m string) string {
+			v := xmlRegexp.FindStringSubmatch(m)
+			return v[1] + replace(v[2], vcrFieldFormatXML) + v[3]
+		})
+		s = formRegexp.ReplaceAllStringFunc(s, func(m string) string {
+			v := formRegexp.FindStringSubmatch(m)
+			return v[1] + replace(v[2], vcrFieldFormatForm)
+		})
+
+		return s
+	}
+}
+
+var vcrSecretPlaceholderRegexp = regexache.MustCompile(`^` + vcrRedactedValue + `-[0-9a-f]{16}$`)
+
+// vcrSecretPlaceholder returns the placeholder that replaces the specified secret value.
+// The same value is always replaced by the same placeholder, so that requests can be matched against recordings.
+func vcrSecretPlaceholder(value string) string {
+	h := sha256.Sum256([]byte(value))
+
+	return vcrRedactedValue + "-" + hex.EncodeToString(h[:8])
+}
+
+// vcrRestoreSecrets replaces placeholders in a response body with the secret values that they replaced.
+// Placeholders for values that have not been seen, e.g. server-generated credentials when replaying, are left as is.
+func vcrRestoreSecrets(body string, isJSON bool) string {
+	return vcrSecretPlaceholderBodyRegexp.ReplaceAllStringFunc(body, func(placeholder string) string {
+		v, ok := vcrSecrets.Load(placeholder)
+		if !ok {
+			return placeholder
+		}
+
+		value := v.(string)
+
+		if isJSON {
+			b, err := json.Marshal(value)
+			if err != nil {
+				return placeholder
+			}
+
+			return string(b[1 : len(b)-1])
+		}
+
+		var buf bytes.Buffer
+		if err := xml.EscapeText(&buf, []byte(value)); err != nil {
+			return placeholder
+		}
+
+		return buf.String()
+	})
+}
+
+var vcrSecretPlaceholderBodyRegexp = regexache.MustCompile(vcrRedactedValue + `-[0-9a-f]{16}`)
+
+// vcrRestoreInteractionSecrets restores secret values in an interaction's response before it is returned to the provider.
+// The interaction is redacted again before it is saved.
+func vcrRestoreInteractionSecrets(i *cassette.Interaction) error {
+	isJSON := false
+	for _, v := range i.Response.Headers["Content-Type"] {
+		if strings.Contains(v, "json") {
+			isJSON = true
+		}
+	}
+
+	i.Response.Body = vcrRestoreSecrets(i.Response.Body, isJSON)
+	vcrSetContentLength(i.Response.Headers, &i.Response.ContentLength, i.Response.Body)
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// chainAssumeRoles returns a copy of the specified AWS SDK for Go v2 configuration whose credentials are
+// obtained by assuming each of the IAM Roles after the first in turn, each hop using the previous hop's credentials.
+// The first IAM Role is assumed by aws-sdk-go-base.
+func (c *Config) chainAssumeRoles(ctx context.Context, cfg aws_sdkv2.Config) (aws_sdkv2.Config, error) {
+	if len(c.AssumeRole) < 2 {
+		return cfg, nil
+	}
+
+	for i, assumeRole := range c.AssumeRole[1:] {
+		i := i + 1
+
+		tflog.Debug(ctx, "Assuming IAM Role", map[string]any{
+			"tf_aws.assume_role.index":    i,
+			"tf_aws.assume_role.role_arn": assumeRole.RoleARN,
+		})
+
+		client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+			if c.STSRegion != "" {
+				o.Region = c.STSRegion
+			}
+			if endpoint := c.Endpoints[names.STS]; endpoint != "" {
+				o.EndpointResolver = sts_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+
+		cfg.Credentials = aws_sdkv2.NewCredentialsCache(stscreds_sdkv2.NewAssumeRoleProvider(client, assumeRole.RoleARN, assumeRoleOptions(assumeRole)))
+
+		// Retrieve the credentials now so that any error is attributed to this hop.
+		if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+			return cfg, fmt.Errorf("assume_role[%d]: assuming IAM Role (%s): %w", i, assumeRole.RoleARN, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+func assumeRoleOptions(assumeRole *awsbase.AssumeRole) func(*stscreds_sdkv2.AssumeRoleOptions) {
+	return func(o *stscreds_sdkv2.AssumeRoleOptions) {
+		if assumeRole.Duration > 0 {
+			o.Duration = assumeRole.Duration
+		}
+
+		if v := assumeRole.ExternalID; v != "" {
+			o.ExternalID = aws_sdkv2.String(v)
+		}
+
+		if v := assumeRole.Policy; v != "" {
+			o.Policy = aws_sdkv2.String(v)
+		}
+
+		for _, v := range assumeRole.PolicyARNs {
+			o.PolicyARNs = append(o.PolicyARNs, ststypes_sdkv2.PolicyDescriptorType{
+				Arn: aws_sdkv2.String(v),
+			})
+		}
+
+		if v := assumeRole.SessionName; v != "" {
+			o.RoleSessionName = v
+		}
+
+		if v := assumeRole.SourceIdentity; v != "" {
+			o.SourceIdentity = aws_sdkv2.String(v)
+		}
+
+		for k, v := range assumeRole.Tags {
+			o.Tags = append(o.Tags, ststypes_sdkv2.Tag{
+				Key:   aws_sdkv2.String(k),
+				Value: aws_sdkv2.String(v),
+			})
+		}
+
+		o.TransitiveTagKeys = assumeRole.TransitiveTagKeys
+	}
+}
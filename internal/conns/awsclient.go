@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"sync"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
@@ -17,6 +19,7 @@ import (
 	mediaconvert_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconvert"
 	s3_sdkv1 "github.com/aws/aws-sdk-go/service/s3"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -31,7 +34,9 @@ type AWSClient struct {
 	ReverseDNSPrefix        string
 	ServicePackages         map[string]ServicePackage
 	Session                 *session_sdkv1.Session
+	TagPolicyCompliance     string
 	TerraformVersion        string
+	ValidatePolicies        bool
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
@@ -39,9 +44,13 @@ type AWSClient struct {
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
+	rateLimiters              map[string]*rateLimiter                   // From provider configuration, keyed by service package name.
+	readBatcher               *readBatcher                              // Nil unless read batching is enabled in provider configuration.
+	regionalClients           map[string]*AWSClient                     // Keyed by AWS Region.
 	s3UsePathStyle            bool                                      // From provider configuration.
 	s3UsEast1RegionalEndpoint endpoints_sdkv1.S3UsEast1RegionalEndpoint // From provider configuration.
 	stsRegion                 string                                    // From provider configuration.
+	tagPolicy                 *effectiveTagPolicy
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -78,6 +87,83 @@ func (client *AWSClient) HTTPClient() *http.Client {
 	return client.httpClient
 }
 
+// UpdateAWSConfig applies the specified function to the AWS SDK for Go v2 configuration from which API clients are created.
+// To have effect it must be called before any AWS SDK for Go v2 API clients are created.
+func (client *AWSClient) UpdateAWSConfig(f func(*aws_sdkv2.Config)) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	if client.awsConfig != nil {
+		f(client.awsConfig)
+	}
+}
+
+// RegionalClient returns an AWSClient whose AWS API clients are configured for the specified AWS Region.
+// The returned client shares provider-level configuration (credentials, default and ignore tags etc.) with this client.
+// Regional clients are created lazily and cached.
+func (client *AWSClient) RegionalClient(region string) *AWSClient {
+	if region == "" || region == client.Region {
+		return client
+	}
+
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	if v, ok := client.regionalClients[region]; ok {
+		return v
+	}
+
+	dnsSuffix := client.DNSSuffix
+	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), region); ok {
+		dnsSuffix = p.DNSSuffix()
+	}
+
+	regionalClient := &AWSClient{
+		AccountID:           client.AccountID,
+		DefaultTagsConfig:   client.DefaultTagsConfig,
+		DNSSuffix:           dnsSuffix,
+		IgnoreTagsConfig:    client.IgnoreTagsConfig,
+		Partition:           client.Partition,
+		Region:              region,
+		ReverseDNSPrefix:    ReverseDNS(dnsSuffix),
+		ServicePackages:     client.ServicePackages,
+		TagPolicyCompliance: client.TagPolicyCompliance,
+		TerraformVersion:    client.TerraformVersion,
+		ValidatePolicies:    client.ValidatePolicies,
+
+		clients:                   make(map[string]any, 0),
+		conns:                     make(map[string]any, 0),
+		endpoints:                 client.endpoints,
+		httpClient:                client.httpClient,
+		rateLimiters:              client.rateLimiters,
+		s3UsePathStyle:            client.s3UsePathStyle,
+		s3UsEast1RegionalEndpoint: client.s3UsEast1RegionalEndpoint,
+		stsRegion:                 client.stsRegion,
+		tagPolicy:                 client.tagPolicy,
+	}
+
+	if client.Session != nil {
+		regionalClient.Session = client.Session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+	}
+
+	if client.readBatcher != nil {
+		regionalClient.readBatcher = newReadBatcher()
+	}
+
+	if client.awsConfig != nil {
+		cfg := client.awsConfig.Copy()
+		cfg.Region = region
+		regionalClient.awsConfig = &cfg
+	}
+
+	if client.regionalClients == nil {
+		client.regionalClients = make(map[string]*AWSClient)
+	}
+	client.regionalClients[region] = regionalClient
+
+	return regionalClient
+}
+
 // APIGatewayInvokeURL returns the Amazon API Gateway (REST APIs) invoke URL for the configured AWS Region.
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/how-to-call-api.html.
 func (client *AWSClient) APIGatewayInvokeURL(restAPIID, stageName string) string {
@@ -135,6 +221,53 @@ func (client *AWSClient) GlobalAcceleratorHostedZoneID() string {
 	return "Z2BJ6XQ5FK7U4H" // See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region
 }
 
+// ListResourceTypes returns the names of the resource types whose existing resources can be listed.
+func (client *AWSClient) ListResourceTypes(ctx context.Context) []string {
+	var typeNames []string
+
+	for _, sp := range client.ServicePackages {
+		if v, ok := sp.(ServicePackageWithListResources); ok {
+			for _, v := range v.ListResources(ctx) {
+				typeNames = append(typeNames, v.TypeName)
+			}
+		}
+	}
+
+	sort.Strings(typeNames)
+
+	return typeNames
+}
+
+// ListResources lists the existing resources of the specified resource type matching the specified filters.
+// The returned import IDs can be used to bring the resources under Terraform management.
+// Only a few resource types support listing; an error naming them is returned for any other resource type.
+func (client *AWSClient) ListResources(ctx context.Context, typeName string, filters map[string]string) ([]types.ListResult, error) {
+	var typeNames []string
+
+	for _, sp := range client.ServicePackages {
+		v, ok := sp.(ServicePackageWithListResources)
+
+		if !ok {
+			continue
+		}
+
+		for _, v := range v.ListResources(ctx) {
+			if v.TypeName != typeName {
+				typeNames = append(typeNames, v.TypeName)
+				continue
+			}
+
+			ctx = NewResourceContext(ctx, sp.ServicePackageName(), v.Name, v.TypeName)
+
+			return v.List(ctx, client, filters)
+		}
+	}
+
+	sort.Strings(typeNames)
+
+	return nil, fmt.Errorf("resource type (%s) does not support listing; supported resource types: %s", typeName, strings.Join(typeNames, ", "))
+}
+
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]any {
 	m := map[string]any{
@@ -151,11 +284,26 @@ func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]a
 		m["sts_region"] = client.stsRegion
 	}
 
+	// Requests to rate limited services, from all Regions, share a single limiter.
+	if limiter, ok := client.rateLimiters[servicePackageName]; ok {
+		if client.Session != nil {
+			m["session"] = rateLimitSession(client.Session, servicePackageName, limiter)
+		}
+		if client.awsConfig != nil {
+			m["aws_sdkv2_config"] = rateLimitAWSConfig(client.awsConfig, servicePackageName, limiter)
+		}
+	}
+
 	return m
 }
 
 // conn returns the AWS SDK for Go v1 API client for the specified service.
+// If a per-resource AWS Region override is present in Context then a client for that Region is returned.
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
+	if inContext, ok := FromContext(ctx); ok {
+		c = c.RegionalClient(inContext.Region)
+	}
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -204,7 +352,12 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
+// If a per-resource AWS Region override is present in Context then a client for that Region is returned.
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
+	if inContext, ok := FromContext(ctx); ok {
+		c = c.RegionalClient(inContext.Region)
+	}
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
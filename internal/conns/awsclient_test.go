@@ -4,7 +4,10 @@
 package conns
 
 import (
+	"context"
 	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -90,3 +93,103 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientRegionalClient(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		AccountID: "123456789012",
+		DNSSuffix: "amazonaws.com",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	if got, want := client.RegionalClient(""), client; got != want {
+		t.Errorf("RegionalClient(\"\") = %p, want %p", got, want)
+	}
+	if got, want := client.RegionalClient("us-west-2"), client; got != want { //lintignore:AWSAT003
+		t.Errorf("RegionalClient(\"us-west-2\") = %p, want %p", got, want)
+	}
+
+	regionalClient := client.RegionalClient("eu-west-1") //lintignore:AWSAT003
+
+	if got, want := regionalClient.Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region = %s, want %s", got, want)
+	}
+	if got, want := regionalClient.AccountID, client.AccountID; got != want {
+		t.Errorf("AccountID = %s, want %s", got, want)
+	}
+	if got, want := regionalClient.RegionalHostname("test"), "test.eu-west-1.amazonaws.com"; got != want { //lintignore:AWSAT003
+		t.Errorf("RegionalHostname = %s, want %s", got, want)
+	}
+	if got, want := client.RegionalClient("eu-west-1"), regionalClient; got != want { //lintignore:AWSAT003
+		t.Errorf("RegionalClient not cached: %p, want %p", got, want)
+	}
+}
+
+type testListServicePackage struct{}
+
+func (testListServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (testListServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (testListServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (testListServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return nil
+}
+
+func (testListServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func (testListServicePackage) ListResources(context.Context) []*types.ServicePackageListResource {
+	return []*types.ServicePackageListResource{
+		{
+			List: func(ctx context.Context, meta any, filters map[string]string) ([]types.ListResult, error) {
+				if inContext, ok := FromContext(ctx); !ok || inContext.ResourceName != "Thing" {
+					return nil, nil
+				}
+
+				return []types.ListResult{{DisplayName: filters["name"], ImportID: "id-" + filters["name"]}}, nil
+			},
+			TypeName: "aws_test_thing",
+			Name:     "Thing",
+		},
+	}
+}
+
+func TestAWSClientListResources(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{
+		ServicePackages: map[string]ServicePackage{
+			"test": testListServicePackage{},
+		},
+	}
+
+	if got, expected := client.ListResourceTypes(ctx), []string{"aws_test_thing"}; len(got) != 1 || got[0] != expected[0] {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	results, err := client.ListResources(ctx, "aws_test_thing", map[string]string{"name": "example"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(results) != 1 || results[0].DisplayName != "example" || results[0].ImportID != "id-example" {
+		t.Errorf("unexpected results: %v", results)
+	}
+
+	if _, err := client.ListResources(ctx, "aws_test_other", nil); err == nil {
+		t.Error("expected error for unsupported resource type")
+	}
+}
@@ -19,14 +19,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	APIRateLimits                  map[string]float64    // Requests per second, keyed by service package name
+	AssumeRole                     []*awsbase.AssumeRole // Applied in order, each using the credentials from the previous one
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	BatchReads                     bool
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
@@ -51,10 +54,12 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyCompliance            string
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+	ValidatePolicies               bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -89,8 +94,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -136,6 +141,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	cfg, err := c.chainAssumeRoles(ctx, cfg)
+
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
 	sess, awsDiags := awsbasev1.GetSession(ctx, &cfg, &awsbaseConfig)
 
@@ -151,6 +162,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	// AWS API requests are traced if tracing is enabled.
+	if tracing.Enabled() {
+		tracing.InstrumentAWSSDKv1Handlers(&sess.Handlers)
+		tracing.InstrumentAWSSDKv2Config(&cfg)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -201,13 +218,23 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
+	client.TagPolicyCompliance = c.TagPolicyCompliance
+	client.tagPolicy = &effectiveTagPolicy{}
 	client.TerraformVersion = c.TerraformVersion
+	client.ValidatePolicies = c.ValidatePolicies
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.rateLimiters = make(map[string]*rateLimiter, len(c.APIRateLimits))
+	for servicePackageName, requestsPerSecond := range c.APIRateLimits {
+		client.rateLimiters[servicePackageName] = newRateLimiter(requestsPerSecond)
+	}
+	if c.BatchReads {
+		client.readBatcher = newReadBatcher()
+	}
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3UsEast1RegionalEndpoint = c.S3UsEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
@@ -25,6 +25,13 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithListResources is an interface that extends ServicePackage with list resources.
+// A list resource enumerates existing resources of a given resource type so that they can be imported.
+type ServicePackageWithListResources interface {
+	ServicePackage
+	ListResources(context.Context) []*types.ServicePackageListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -36,24 +43,28 @@ var (
 // InContext represents the resource information kept in Context.
 type InContext struct {
 	IsDataSource       bool   // Data source?
+	Region             string // Per-resource AWS Region override, empty for the provider's configured Region
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
+	TypeName           string // Terraform type name, e.g. "aws_subnet"
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
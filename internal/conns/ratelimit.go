@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// rateLimiter is a token-bucket limiter for the AWS API requests made to a single service.
+// The bucket holds at most one second's worth of tokens (and at least one) so that
+// short bursts are allowed but the sustained request rate is bounded.
+type rateLimiter struct {
+	burst             float64
+	last              time.Time
+	lock              sync.Mutex
+	now               func() time.Time
+	requestsPerSecond float64
+	tokens            float64
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	burst := math.Max(1, math.Ceil(requestsPerSecond))
+
+	return &rateLimiter{
+		burst:             burst,
+		now:               time.Now,
+		requestsPerSecond: requestsPerSecond,
+		tokens:            burst,
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before the token may be used.
+func (l *rateLimiter) reserve() time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.requestsPerSecond)
+	}
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.requestsPerSecond * float64(time.Second))
+}
+
+// wait blocks until a token is available or the Context is done.
+// It returns the time spent waiting.
+func (l *rateLimiter) wait(ctx context.Context) (time.Duration, error) {
+	d := l.reserve()
+	if d <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return d, ctx.Err()
+	case <-timer.C:
+		return d, nil
+	}
+}
+
+// rateLimitMetrics accumulates rate limiting metrics for a single AWS API operation, across all of its attempts.
+type rateLimitMetrics struct {
+	attempts int
+	lock     sync.Mutex
+	wait     time.Duration
+}
+
+func (m *rateLimitMetrics) add(wait time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.attempts++
+	m.wait += wait
+}
+
+type rateLimitMetricsKey struct{}
+
+func rateLimitMetricsFromContext(ctx context.Context) *rateLimitMetrics {
+	if v, ok := ctx.Value(rateLimitMetricsKey{}).(*rateLimitMetrics); ok {
+		return v
+	}
+
+	return &rateLimitMetrics{}
+}
+
+// logRateLimitMetrics logs the specified operation's rate limiting metrics.
+func logRateLimitMetrics(ctx context.Context, servicePackageName, operationName string, requestsPerSecond float64, m *rateLimitMetrics) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	tflog.Debug(ctx, "AWS API rate limit", map[string]any{
+		"tf_aws.api_rate_limit.service":             servicePackageName,
+		"tf_aws.api_rate_limit.operation":           operationName,
+		"tf_aws.api_rate_limit.requests_per_second": requestsPerSecond,
+		"tf_aws.api_rate_limit.attempts":            m.attempts,
+		"tf_aws.api_rate_limit.wait_ms":             m.wait.Milliseconds(),
+	})
+}
+
+// rateLimitSession returns a copy of the specified AWS SDK for Go v1 session whose handlers apply the rate limit.
+// Each attempt of an operation, including retries, waits for a token.
+func rateLimitSession(sess *session_sdkv1.Session, servicePackageName string, limiter *rateLimiter) *session_sdkv1.Session {
+	sess = sess.Copy()
+
+	sess.Handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "APIRateLimitMetrics",
+		Fn: func(r *request_sdkv1.Request) {
+			r.SetContext(context.WithValue(r.Context(), rateLimitMetricsKey{}, &rateLimitMetrics{}))
+		},
+	})
+	sess.Handlers.Send.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "APIRateLimit",
+		Fn: func(r *request_sdkv1.Request) {
+			ctx := r.Context()
+			wait, err := limiter.wait(ctx)
+			rateLimitMetricsFromContext(ctx).add(wait)
+			if err != nil {
+				r.Error = err
+			}
+		},
+	})
+	sess.Handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "APIRateLimitMetrics",
+		Fn: func(r *request_sdkv1.Request) {
+			ctx := r.Context()
+			logRateLimitMetrics(ctx, servicePackageName, r.Operation.Name, limiter.requestsPerSecond, rateLimitMetricsFromContext(ctx))
+		},
+	})
+
+	return sess
+}
+
+// rateLimitAWSConfig returns a copy of the specified AWS SDK for Go v2 configuration whose middleware applies the rate limit.
+// Each attempt of an operation, including retries, waits for a token.
+func rateLimitAWSConfig(cfg *aws_sdkv2.Config, servicePackageName string, limiter *rateLimiter) *aws_sdkv2.Config {
+	v := cfg.Copy()
+
+	v.APIOptions = append(v.APIOptions, func(stack *middleware.Stack) error {
+		// Initialize middleware added After runs once the operation's service metadata is in Context.
+		if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("APIRateLimitMetrics", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			m := &rateLimitMetrics{}
+			ctx = context.WithValue(ctx, rateLimitMetricsKey{}, m)
+			defer logRateLimitMetrics(ctx, servicePackageName, awsmiddleware.GetOperationName(ctx), limiter.requestsPerSecond, m)
+
+			return next.HandleInitialize(ctx, in)
+		}), middleware.After); err != nil {
+			return err
+		}
+
+		// Finalize middleware added After runs after the retry middleware, i.e. once per attempt.
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("APIRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			wait, err := limiter.wait(ctx)
+			rateLimitMetricsFromContext(ctx).add(wait)
+			if err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+
+			return next.HandleFinalize(ctx, in)
+		}), middleware.After)
+	})
+
+	return &v
+}
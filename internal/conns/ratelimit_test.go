@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.September, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(4)
+	limiter.now = func() time.Time { return now }
+
+	// The initial burst is one second's worth of requests.
+	for i := 0; i < 4; i++ {
+		if got := limiter.reserve(); got != 0 {
+			t.Errorf("burst request %d: got wait %s, want 0", i, got)
+		}
+	}
+
+	if got, want := limiter.reserve(), 250*time.Millisecond; got != want {
+		t.Errorf("got wait %s, want %s", got, want)
+	}
+	if got, want := limiter.reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("got wait %s, want %s", got, want)
+	}
+
+	// After the owed tokens are replenished the bucket refills up to the burst size.
+	now = now.Add(10 * time.Second)
+	for i := 0; i < 4; i++ {
+		if got := limiter.reserve(); got != 0 {
+			t.Errorf("refilled request %d: got wait %s, want 0", i, got)
+		}
+	}
+	if got := limiter.reserve(); got == 0 {
+		t.Error("got no wait after burst, want wait")
+	}
+}
+
+func TestRateLimiterFractionalRate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.September, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(0.5)
+	limiter.now = func() time.Time { return now }
+
+	if got := limiter.reserve(); got != 0 {
+		t.Errorf("got wait %s, want 0", got)
+	}
+	if got, want := limiter.reserve(), 2*time.Second; got != want {
+		t.Errorf("got wait %s, want %s", got, want)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(0.01)
+	limiter.reserve()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := limiter.wait(ctx); err == nil {
+		t.Error("got no error, want context canceled")
+	}
+}
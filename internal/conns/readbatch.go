@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// readBatchWindow is how long a batch stays open for further reads after the first read is added.
+	readBatchWindow = 20 * time.Millisecond
+	// readBatchMaxSize is the maximum number of IDs in a batch.
+	// A batch that reaches this size is sent immediately.
+	readBatchMaxSize = 100
+	// readBatchTimeout is the maximum time a batch's reads may take.
+	readBatchTimeout = 5 * time.Minute
+)
+
+// BatchReadFunc reads the resources with the specified IDs, typically with a single filtered (and paginated) API call.
+// The results are keyed by ID. IDs of resources that don't exist are omitted from the results.
+type BatchReadFunc[T any] func(context.Context, []string) (map[string]T, error)
+
+// BatchRead reads the resource with the specified ID.
+// If read batching is enabled in the provider configuration then concurrent reads with the same key,
+// e.g. the AWS API operation name, are coalesced into a single call to fn. Otherwise fn is called with the single ID.
+// The boolean return value reports whether the resource was found.
+// A batch is read with the Context and fn of just one of its reads, either the first or the one that fills the batch,
+// so fn's results must not depend on the calling resource: Context values such as logging and tracing fields,
+// and any API client captured by fn, are those of that read. Batches are per AWS Region, so an API client
+// obtained from the AWSClient is the same for every read in a batch.
+func BatchRead[T any](ctx context.Context, c *AWSClient, key, id string, fn BatchReadFunc[T]) (T, bool, error) {
+	if inContext, ok := FromContext(ctx); ok {
+		c = c.RegionalClient(inContext.Region)
+	}
+
+	var zero T
+
+	if c.readBatcher == nil {
+		results, err := fn(ctx, []string{id})
+		if err != nil {
+			return zero, false, err
+		}
+
+		v, ok := results[id]
+
+		return v, ok, nil
+	}
+
+	v, ok, err := c.readBatcher.read(ctx, key, id, func(ctx context.Context, ids []string) (map[string]any, error) {
+		results, err := fn(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		m := make(map[string]any, len(results))
+		for k, v := range results {
+			m[k] = v
+		}
+
+		return m, nil
+	})
+	if err != nil || !ok {
+		return zero, false, err
+	}
+
+	return v.(T), true, nil
+}
+
+// ReadBatchingEnabled returns whether concurrent reads may be coalesced by BatchRead.
+func (client *AWSClient) ReadBatchingEnabled() bool {
+	return client.readBatcher != nil
+}
+
+// readBatcher coalesces concurrent reads of individual resources into batches.
+// Each AWSClient (i.e. each AWS Region) has its own readBatcher.
+type readBatcher struct {
+	batches map[string]*readBatch // Open batches, keyed by batch key.
+	lock    sync.Mutex
+	maxSize int
+	window  time.Duration
+}
+
+func newReadBatcher() *readBatcher {
+	return &readBatcher{
+		batches: make(map[string]*readBatch),
+		maxSize: readBatchMaxSize,
+		window:  readBatchWindow,
+	}
+}
+
+type readBatch struct {
+	done    chan struct{}
+	err     error
+	ids     []string
+	once    sync.Once
+	results map[string]any
+}
+
+// send reads the batch's resources and wakes all waiters. It is safe to call more than once.
+// The batch is shared by all its waiters so it is not canceled with the specified Context,
+// whose values are retained, but has its own timeout.
+// The Context and fn are those of the read that triggered the send; see BatchRead.
+func (b *readBatch) send(ctx context.Context, fn BatchReadFunc[any]) {
+	b.once.Do(func() {
+		ctx, cancel := context.WithTimeout(detachedContext{ctx}, readBatchTimeout)
+		defer cancel()
+
+		b.results, b.err = fn(ctx, b.ids)
+		close(b.done)
+	})
+}
+
+// detachedContext is a Context that carries its parent's values but is never canceled.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+// read adds the specified ID to the open batch with the specified key, opening a new batch if necessary,
+// and waits for the batch's results.
+// A read that is canceled stops waiting but does not cancel the batch.
+func (rb *readBatcher) read(ctx context.Context, key, id string, fn BatchReadFunc[any]) (any, bool, error) {
+	rb.lock.Lock()
+	batch, ok := rb.batches[key]
+	if !ok {
+		batch = &readBatch{
+			done: make(chan struct{}),
+		}
+		rb.batches[key] = batch
+		time.AfterFunc(rb.window, func() {
+			rb.close(key, batch)
+			batch.send(ctx, fn)
+		})
+	}
+	if !slices.Contains(batch.ids, id) {
+		batch.ids = append(batch.ids, id)
+	}
+	full := len(batch.ids) >= rb.maxSize
+	if full {
+		delete(rb.batches, key)
+	}
+	rb.lock.Unlock()
+
+	if full {
+		go batch.send(ctx, fn)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	case <-batch.done:
+	}
+
+	if batch.err != nil {
+		return nil, false, batch.err
+	}
+
+	v, ok := batch.results[id]
+
+	return v, ok, nil
+}
+
+// close stops the specified batch accepting further reads.
+func (rb *readBatcher) close(key string, batch *readBatch) {
+	rb.lock.Lock()
+	defer rb.lock.Unlock()
+
+	if rb.batches[key] == batch {
+		delete(rb.batches, key)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBatchRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{
+		readBatcher: &readBatcher{
+			batches: make(map[string]*readBatch),
+			maxSize: 100,
+			window:  50 * time.Millisecond,
+		},
+	}
+
+	var calls atomic.Int32
+	fn := func(_ context.Context, ids []string) (map[string]string, error) {
+		calls.Add(1)
+		m := make(map[string]string)
+		for _, id := range ids {
+			if id != "missing" {
+				m[id] = "value-" + id
+			}
+		}
+		return m, nil
+	}
+
+	ids := []string{"a", "b", "c", "a", "missing"}
+	type result struct {
+		v   string
+		ok  bool
+		err error
+	}
+	results := make([]result, len(ids))
+
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			v, ok, err := BatchRead(ctx, client, "test", id, fn)
+			results[i] = result{v, ok, err}
+		}(i, id)
+	}
+	wg.Wait()
+
+	if got, want := calls.Load(), int32(1); got != want {
+		t.Errorf("got %d calls, want %d", got, want)
+	}
+
+	for i, id := range ids {
+		got := results[i]
+		if got.err != nil {
+			t.Errorf("%s: unexpected error: %s", id, got.err)
+			continue
+		}
+		if id == "missing" {
+			if got.ok {
+				t.Errorf("%s: got found, want not found", id)
+			}
+			continue
+		}
+		if want := "value-" + id; !got.ok || got.v != want {
+			t.Errorf("%s: got (%q, %t), want (%q, true)", id, got.v, got.ok, want)
+		}
+	}
+}
+
+func TestBatchReadMaxSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{
+		readBatcher: &readBatcher{
+			batches: make(map[string]*readBatch),
+			maxSize: 2,
+			window:  time.Hour,
+		},
+	}
+
+	var calls atomic.Int32
+	fn := func(_ context.Context, ids []string) (map[string]int, error) {
+		calls.Add(1)
+		m := make(map[string]int)
+		for _, id := range ids {
+			m[id] = len(ids)
+		}
+		return m, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			if _, _, err := BatchRead(ctx, client, "test", id, fn); err != nil {
+				t.Errorf("%s: unexpected error: %s", id, err)
+			}
+		}(fmt.Sprint(i))
+	}
+	wg.Wait()
+
+	if got, want := calls.Load(), int32(2); got != want {
+		t.Errorf("got %d calls, want %d", got, want)
+	}
+}
+
+func TestBatchReadError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{
+		readBatcher: newReadBatcher(),
+	}
+
+	want := errors.New("test error")
+	fn := func(context.Context, []string) (map[string]string, error) {
+		return nil, want
+	}
+
+	if _, _, err := BatchRead(ctx, client, "test", "a", fn); !errors.Is(err, want) {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+}
+
+func TestBatchReadCanceled(t *testing.T) {
+	t.Parallel()
+
+	client := &AWSClient{
+		readBatcher: &readBatcher{
+			batches: make(map[string]*readBatch),
+			maxSize: 100,
+			window:  200 * time.Millisecond,
+		},
+	}
+
+	fn := func(ctx context.Context, ids []string) (map[string]int, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		m := make(map[string]int)
+		for _, id := range ids {
+			m[id] = len(ids)
+		}
+		return m, nil
+	}
+
+	// The first read opens the batch and is then canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error)
+	go func() {
+		_, _, err := BatchRead(ctx, client, "test", "a", fn)
+		errCh <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	if err := <-errCh; !errors.Is(err, context.Canceled) {
+		t.Errorf("a: got error %v, want %v", err, context.Canceled)
+	}
+
+	v, ok, err := BatchRead(context.Background(), client, "test", "b", fn)
+
+	if err != nil {
+		t.Fatalf("b: unexpected error: %s", err)
+	}
+	if !ok || v != 2 {
+		t.Errorf("b: got (%d, %t), want (2, true)", v, ok)
+	}
+}
+
+func TestBatchReadDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{}
+
+	fn := func(_ context.Context, ids []string) (map[string]int, error) {
+		return map[string]int{ids[0]: len(ids)}, nil
+	}
+
+	v, ok, err := BatchRead(ctx, client, "test", "a", fn)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !ok || v != 1 {
+		t.Errorf("got (%d, %t), want (1, true)", v, ok)
+	}
+}
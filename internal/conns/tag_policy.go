@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	organizations_sdkv1 "github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// effectiveTagPolicy caches the account's effective tag policy.
+// It is shared between a client and its Regional clients.
+type effectiveTagPolicy struct {
+	lock   sync.Mutex
+	found  bool
+	policy *tftags.Policy
+}
+
+// EffectiveTagPolicy returns the account's effective AWS Organizations tag policy.
+// A nil policy is returned if the account is not a member of an organization or no tag policy applies to it.
+// The policy is retrieved successfully at most once per provider instance; errors are not cached.
+func (client *AWSClient) EffectiveTagPolicy(ctx context.Context) (*tftags.Policy, error) {
+	if client.tagPolicy == nil {
+		return nil, nil
+	}
+
+	client.tagPolicy.lock.Lock()
+	defer client.tagPolicy.lock.Unlock()
+
+	if client.tagPolicy.found {
+		return client.tagPolicy.policy, nil
+	}
+
+	policy, err := findEffectiveTagPolicy(ctx, client.OrganizationsConn(ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	client.tagPolicy.found = true
+	client.tagPolicy.policy = policy
+
+	return policy, nil
+}
+
+func findEffectiveTagPolicy(ctx context.Context, conn *organizations_sdkv1.Organizations) (*tftags.Policy, error) {
+	input := &organizations_sdkv1.DescribeEffectivePolicyInput{
+		PolicyType: aws_sdkv1.String(organizations_sdkv1.EffectivePolicyTypeTagPolicy),
+	}
+
+	output, err := conn.DescribeEffectivePolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, organizations_sdkv1.ErrCodeAWSOrganizationsNotInUseException, organizations_sdkv1.ErrCodeEffectivePolicyNotFoundException) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	return tftags.NewPolicy(aws_sdkv1.StringValue(output.EffectivePolicy.PolicyContent))
+}
+
+// SetEffectiveTagPolicy sets the account's effective tag policy so that it is not read from AWS Organizations.
+func (client *AWSClient) SetEffectiveTagPolicy(policy *tftags.Policy) {
+	client.tagPolicy = &effectiveTagPolicy{
+		found:  true,
+		policy: policy,
+	}
+}
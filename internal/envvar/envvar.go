@@ -84,6 +84,18 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to configure the provider
+const (
+	// Default for the provider's tag_policy_compliance argument
+	TagPolicyCompliance = "TF_AWS_TAG_POLICY_COMPLIANCE"
+
+	// Enables OpenTelemetry tracing. Valid values are "otlp" and "file"
+	Tracing = "TF_AWS_TRACING"
+
+	// Name of the file that spans are written to when TF_AWS_TRACING is "file"
+	TracingFile = "TF_AWS_TRACING_FILE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -62,7 +62,7 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 		return
 	}
 
-	defaultTagsConfig := r.Meta().DefaultTagsConfig
+	defaultTagsConfig := tftags.DefaultConfigFromContext(ctx, r.Meta().DefaultTagsConfig)
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
 
 	var planTags types.Map
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -24,7 +26,7 @@ import (
 // target data type) are copied.
 func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
 	var diags diag.Diagnostics
-	expander := &autoExpander{}
+	expander := &autoExpander{options: newAutoFlexOptions()}
 
 	for _, optFn := range optFns {
 		optFn(expander)
@@ -47,7 +49,7 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 // suitable target data type) are copied.
 func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
 	var diags diag.Diagnostics
-	flattener := &autoFlattener{}
+	flattener := &autoFlattener{options: newAutoFlexOptions()}
 
 	for _, optFn := range optFns {
 		optFn(flattener)
@@ -65,14 +67,100 @@ func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOpt
 // autoFlexer is the interface implemented by an auto-flattener or expander.
 type autoFlexer interface {
 	convert(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+	getOptions() *autoFlexOptions
 }
 
 // AutoFlexOptionsFunc is a type alias for an autoFlexer functional option.
 type AutoFlexOptionsFunc func(autoFlexer)
 
-type autoExpander struct{}
+// autoFlexOptions holds the options applied to an auto-flattener or expander.
+type autoFlexOptions struct {
+	// fieldNameMappings maps source field names to target field names.
+	fieldNameMappings map[string]string
+	// ignoredFieldNames are source or target field names that are not copied.
+	ignoredFieldNames map[string]bool
+	// unions maps an AWS API union interface type to its member types.
+	unions map[reflect.Type][]reflect.Type
+}
+
+func newAutoFlexOptions() *autoFlexOptions {
+	return &autoFlexOptions{
+		fieldNameMappings: make(map[string]string),
+		ignoredFieldNames: make(map[string]bool),
+		unions:            make(map[reflect.Type][]reflect.Type),
+	}
+}
+
+// WithFieldNameMapping maps the resource's data structure field `tfName` to
+// the AWS API data structure field `awsName`.
+// The mapping applies in both directions, i.e. on Expand and on Flatten.
+func WithFieldNameMapping(tfName, awsName string) AutoFlexOptionsFunc {
+	return func(flexer autoFlexer) {
+		switch flexer.(type) {
+		case *autoExpander:
+			flexer.getOptions().fieldNameMappings[tfName] = awsName
+		case *autoFlattener:
+			flexer.getOptions().fieldNameMappings[awsName] = tfName
+		}
+	}
+}
+
+// WithIgnoredFieldNames skips the specified fields, which may be named
+// as in either the resource's or the AWS API data structure.
+func WithIgnoredFieldNames(fieldNames ...string) AutoFlexOptionsFunc {
+	return func(flexer autoFlexer) {
+		for _, fieldName := range fieldNames {
+			flexer.getOptions().ignoredFieldNames[fieldName] = true
+		}
+	}
+}
+
+// WithUnion registers the members of the AWS SDK for Go v2 union interface T.
+// Each member, e.g. `&types.PolicyDefinitionMemberStatic{}`, corresponds to the
+// nested block field of the same name with the `<T>Member` prefix removed, e.g. `Static`.
+// The union itself is represented by a nested block containing one field per member,
+// of which exactly one is set.
+func WithUnion[T any](members ...T) AutoFlexOptionsFunc {
+	tUnion := reflect.TypeOf((*T)(nil)).Elem()
+	tMembers := make([]reflect.Type, 0, len(members))
+	for _, member := range members {
+		tMembers = append(tMembers, reflect.TypeOf(member))
+	}
+
+	return func(flexer autoFlexer) {
+		flexer.getOptions().unions[tUnion] = tMembers
+	}
+}
+
+// unionMemberName returns the name of the nested block field corresponding to the specified union member type.
+func unionMemberName(tUnion, tMember reflect.Type) string {
+	if tMember.Kind() == reflect.Ptr {
+		tMember = tMember.Elem()
+	}
+
+	return strings.TrimPrefix(tMember.Name(), tUnion.Name()+"Member")
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+type autoExpander struct {
+	options *autoFlexOptions
+}
+
+type autoFlattener struct {
+	options *autoFlexOptions
+}
 
-type autoFlattener struct{}
+func (expander autoExpander) getOptions() *autoFlexOptions {
+	return expander.options
+}
+
+func (flattener autoFlattener) getOptions() *autoFlexOptions {
+	return flattener.options
+}
 
 // autoFlexConvert converts `from` to `to` using the specified auto-flexer.
 func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
@@ -122,6 +210,7 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		return diags
 	}
 
+	options := flexer.getOptions()
 	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
 		field := typFrom.Field(i)
 		if field.PkgPath != "" {
@@ -131,7 +220,14 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		if fieldName == "Tags" {
 			continue // Resource tags are handled separately.
 		}
-		toFieldVal := valTo.FieldByName(fieldName)
+		toFieldName := fieldName
+		if v, ok := options.fieldNameMappings[fieldName]; ok {
+			toFieldName = v
+		}
+		if options.ignoredFieldNames[fieldName] || options.ignoredFieldNames[toFieldName] {
+			continue // Field explicitly ignored.
+		}
+		toFieldVal := valTo.FieldByName(toFieldName)
 		if !toFieldVal.IsValid() {
 			continue // Corresponding field not found in to.
 		}
@@ -164,6 +260,16 @@ func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.V
 	}
 
 	switch vFrom := vFrom.(type) {
+	// Custom types.
+	// These must precede the primitive types that they also implement.
+	case fwtypes.Duration:
+		diags.Append(expander.duration(ctx, vFrom, vTo)...)
+		return diags
+
+	case fwtypes.TimestampValue:
+		diags.Append(expander.timestamp(ctx, vFrom, vTo)...)
+		return diags
+
 	// Primitive types.
 	case basetypes.BoolValuable:
 		diags.Append(expander.bool(ctx, vFrom, vTo)...)
@@ -343,18 +449,20 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 	switch vTo.Kind() {
 	case reflect.String:
 		//
-		// types.String -> string.
+		// types.String -> string (or string-based enum).
 		//
 		vTo.SetString(v.ValueString())
 		return diags
 
 	case reflect.Ptr:
-		switch vTo.Type().Elem().Kind() {
+		switch tElem := vTo.Type().Elem(); tElem.Kind() {
 		case reflect.String:
 			//
-			// types.String -> *string.
+			// types.String -> *string (or pointer to string-based enum).
 			//
-			vTo.Set(reflect.ValueOf(v.ValueStringPointer()))
+			to := reflect.New(tElem)
+			to.Elem().SetString(v.ValueString())
+			vTo.Set(to)
 			return diags
 		}
 	}
@@ -367,6 +475,58 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 	return diags
 }
 
+// duration copies a Plugin Framework Duration value to a compatible AWS API value.
+func (expander autoExpander) duration(ctx context.Context, vFrom fwtypes.Duration, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch tTo := vTo.Type(); {
+	case tTo == durationType:
+		//
+		// fwtypes.Duration -> time.Duration.
+		//
+		vTo.Set(reflect.ValueOf(vFrom.ValueDuration()))
+		return diags
+
+	case tTo.Kind() == reflect.Ptr && tTo.Elem() == durationType:
+		//
+		// fwtypes.Duration -> *time.Duration.
+		//
+		to := vFrom.ValueDuration()
+		vTo.Set(reflect.ValueOf(&to))
+		return diags
+	}
+
+	// fwtypes.Duration -> string or *string.
+	diags.Append(expander.string(ctx, vFrom, vTo)...)
+	return diags
+}
+
+// timestamp copies a Plugin Framework Timestamp value to a compatible AWS API value.
+func (expander autoExpander) timestamp(ctx context.Context, vFrom fwtypes.TimestampValue, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch tTo := vTo.Type(); {
+	case tTo == timeType:
+		//
+		// fwtypes.Timestamp -> time.Time.
+		//
+		vTo.Set(reflect.ValueOf(vFrom.ValueTimestamp()))
+		return diags
+
+	case tTo.Kind() == reflect.Ptr && tTo.Elem() == timeType:
+		//
+		// fwtypes.Timestamp -> *time.Time.
+		//
+		to := vFrom.ValueTimestamp()
+		vTo.Set(reflect.ValueOf(&to))
+		return diags
+	}
+
+	// fwtypes.Timestamp -> string or *string.
+	diags.Append(expander.string(ctx, vFrom, vTo)...)
+	return diags
+}
+
 // list copies a Plugin Framework List(ish) value to a compatible AWS API value.
 func (expander autoExpander) list(ctx context.Context, vFrom basetypes.ListValuable, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -406,7 +566,7 @@ func (expander autoExpander) listOfString(ctx context.Context, vFrom basetypes.L
 		switch tSliceElem := vTo.Type().Elem(); tSliceElem.Kind() {
 		case reflect.String:
 			//
-			// types.List(OfString) -> []string.
+			// types.List(OfString) -> []string (or slice of string-based enum).
 			//
 			var to []string
 			diags.Append(vFrom.ElementsAs(ctx, &to, false)...)
@@ -414,7 +574,7 @@ func (expander autoExpander) listOfString(ctx context.Context, vFrom basetypes.L
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(to))
+			vTo.Set(stringSliceValue(vTo.Type(), to))
 			return diags
 
 		case reflect.Ptr:
@@ -478,7 +638,7 @@ func (expander autoExpander) mapOfString(ctx context.Context, vFrom basetypes.Ma
 			switch tMapElem := vTo.Type().Elem(); tMapElem.Kind() {
 			case reflect.String:
 				//
-				// types.Map(OfString) -> map[string]string.
+				// types.Map(OfString) -> map[string]string (or map of string-based enum).
 				//
 				var to map[string]string
 				diags.Append(vFrom.ElementsAs(ctx, &to, false)...)
@@ -486,7 +646,7 @@ func (expander autoExpander) mapOfString(ctx context.Context, vFrom basetypes.Ma
 					return diags
 				}
 
-				vTo.Set(reflect.ValueOf(to))
+				vTo.Set(stringMapValue(vTo.Type(), to))
 				return diags
 
 			case reflect.Ptr:
@@ -555,7 +715,7 @@ func (expander autoExpander) setOfString(ctx context.Context, vFrom basetypes.Se
 		switch tSliceElem := vTo.Type().Elem(); tSliceElem.Kind() {
 		case reflect.String:
 			//
-			// types.Set(OfString) -> []string.
+			// types.Set(OfString) -> []string (or slice of string-based enum).
 			//
 			var to []string
 			diags.Append(vFrom.ElementsAs(ctx, &to, false)...)
@@ -563,7 +723,7 @@ func (expander autoExpander) setOfString(ctx context.Context, vFrom basetypes.Se
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(to))
+			vTo.Set(stringSliceValue(vTo.Type(), to))
 			return diags
 
 		case reflect.Ptr:
@@ -597,6 +757,22 @@ func (expander autoExpander) nestedObject(ctx context.Context, vFrom fwtypes.Nes
 	var diags diag.Diagnostics
 
 	switch tTo := vTo.Type(); vTo.Kind() {
+	case reflect.Struct:
+		//
+		// types.List(OfObject) -> struct.
+		//
+		diags.Append(expander.nestedObjectToStruct(ctx, vFrom, tTo, vTo)...)
+		return diags
+
+	case reflect.Interface:
+		if tMembers, ok := expander.options.unions[tTo]; ok {
+			//
+			// types.List(OfObject) -> union interface.
+			//
+			diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, tMembers, vTo)...)
+			return diags
+		}
+
 	case reflect.Ptr:
 		switch tElem := tTo.Elem(); tElem.Kind() {
 		case reflect.Struct:
@@ -696,6 +872,50 @@ func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwty
 	return diags
 }
 
+// nestedObjectToUnion copies a Plugin Framework NestedObjectValue to a compatible AWS API union interface value.
+// Exactly one of the nested Object's member fields must be set.
+func (expander autoExpander) nestedObjectToUnion(ctx context.Context, vFrom fwtypes.NestedObjectValue, tUnion reflect.Type, tMembers []reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	f := reflect.ValueOf(from).Elem()
+	var to reflect.Value
+	for _, tMember := range tMembers {
+		memberName := unionMemberName(tUnion, tMember)
+		fieldVal := f.FieldByName(memberName)
+		if !fieldVal.IsValid() {
+			continue // Corresponding field not found in from.
+		}
+		if v, ok := fieldVal.Interface().(attr.Value); !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		if to.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union %s: more than one member set", tUnion))
+			return diags
+		}
+
+		// Create a new member and copy into its Value field.
+		to = reflect.New(tMember.Elem())
+		diags.Append(expander.convert(ctx, fieldVal, to.Elem().FieldByName("Value"))...)
+		if diags.HasError() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union member (%s)", memberName))
+			return diags
+		}
+	}
+
+	if to.IsValid() {
+		vTo.Set(to)
+	}
+
+	return diags
+}
+
 // convert converts a single AWS API value to its Plugin Framework equivalent.
 func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -735,6 +955,14 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 	case reflect.Map:
 		diags.Append(flattener.map_(ctx, vFrom, tTo, vTo)...)
 		return diags
+
+	case reflect.Struct:
+		diags.Append(flattener.struct_(ctx, vFrom, tTo, vTo)...)
+		return diags
+
+	case reflect.Interface:
+		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		return diags
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -803,6 +1031,14 @@ func (flattener autoFlattener) float(ctx context.Context, vFrom reflect.Value, t
 func (flattener autoFlattener) int(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	if vFrom.Type() == durationType && tTo.Equal(fwtypes.DurationType) {
+		//
+		// time.Duration -> fwtypes.Duration.
+		//
+		vTo.Set(reflect.ValueOf(fwtypes.DurationValue(time.Duration(vFrom.Int()))))
+		return diags
+	}
+
 	switch tTo := tTo.(type) {
 	case basetypes.Int64Typable:
 		v, d := tTo.ValueFromInt64(ctx, types.Int64Value(vFrom.Int()))
@@ -839,7 +1075,7 @@ func (flattener autoFlattener) string(ctx context.Context, vFrom reflect.Value,
 		}
 
 		//
-		// string -> types.String.
+		// string (or string-based enum) -> types.String.
 		//
 		vTo.Set(reflect.ValueOf(v))
 		return diags
@@ -878,6 +1114,11 @@ func (flattener autoFlattener) ptr(ctx context.Context, vFrom reflect.Value, tTo
 
 	case reflect.Int32, reflect.Int64:
 		if vFrom.IsNil() {
+			if tTo.Equal(fwtypes.DurationType) {
+				vTo.Set(reflect.ValueOf(fwtypes.DurationNull()))
+				return diags
+			}
+
 			vTo.Set(reflect.ValueOf(types.Int64Null()))
 			return diags
 		}
@@ -887,6 +1128,18 @@ func (flattener autoFlattener) ptr(ctx context.Context, vFrom reflect.Value, tTo
 
 	case reflect.String:
 		if vFrom.IsNil() {
+			// Custom String(ish) types have their own null value.
+			if tTo, ok := tTo.(basetypes.StringTypable); ok {
+				v, d := tTo.ValueFromString(ctx, types.StringNull())
+				diags.Append(d...)
+				if diags.HasError() {
+					return diags
+				}
+
+				vTo.Set(reflect.ValueOf(v))
+				return diags
+			}
+
 			vTo.Set(reflect.ValueOf(types.StringNull()))
 			return diags
 		}
@@ -895,6 +1148,16 @@ func (flattener autoFlattener) ptr(ctx context.Context, vFrom reflect.Value, tTo
 		return diags
 
 	case reflect.Struct:
+		if vFrom.Type().Elem() == timeType {
+			if vFrom.IsNil() {
+				vTo.Set(reflect.ValueOf(fwtypes.NewTimestampNull()))
+				return diags
+			}
+
+			diags.Append(flattener.struct_(ctx, vElem, tTo, vTo)...)
+			return diags
+		}
+
 		if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
 			//
 			// *struct -> types.List(OfObject).
@@ -918,147 +1181,293 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 
 	switch tSliceElem := vFrom.Type().Elem(); tSliceElem.Kind() {
 	case reflect.String:
-		switch tTo := tTo.(type) {
-		case basetypes.ListTypable:
+		//
+		// []string (or slice of string-based enum) -> types.List/types.Set(OfString).
+		//
+		diags.Append(flattener.sliceOfString(ctx, vFrom, tTo, vTo)...)
+		return diags
+
+	case reflect.Ptr:
+		switch tSliceElem.Elem().Kind() {
+		case reflect.String:
 			//
-			// []string -> types.List(OfString).
+			// []*string -> types.List/types.Set(OfString).
 			//
-			if vFrom.IsNil() {
-				vTo.Set(reflect.ValueOf(types.ListNull(types.StringType)))
-				return diags
-			}
+			diags.Append(flattener.sliceOfString(ctx, vFrom, tTo, vTo)...)
+			return diags
 
-			from := vFrom.Interface().([]string)
-			elements := make([]attr.Value, len(from))
-			for i, v := range from {
-				elements[i] = types.StringValue(v)
-			}
-			list, d := types.ListValue(types.StringType, elements)
-			diags.Append(d...)
-			if diags.HasError() {
+		case reflect.Struct:
+			if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
+				//
+				// []*struct -> types.List(OfObject).
+				//
+				diags.Append(flattener.sliceOfStructNestedObject(ctx, vFrom, tTo, vTo)...)
 				return diags
 			}
+		}
 
-			to, d := tTo.ValueFromList(ctx, list)
-			diags.Append(d...)
-			if diags.HasError() {
+	case reflect.Struct:
+		if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
+			//
+			// []struct -> types.List(OfObject).
+			//
+			diags.Append(flattener.sliceOfStructNestedObject(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
+	}
+
+	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+		"from": vFrom.Kind(),
+		"to":   tTo,
+	})
+
+	return diags
+}
+
+// map_ copies an AWS API map value to a compatible Plugin Framework value.
+func (flattener autoFlattener) map_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch tMapKey := vFrom.Type().Key(); tMapKey.Kind() {
+	case reflect.String:
+		switch tMapElem := vFrom.Type().Elem(); tMapElem.Kind() {
+		case reflect.String:
+			//
+			// map[string]string (or map of string-based enum) -> types.Map(OfString).
+			//
+			diags.Append(flattener.mapOfString(ctx, vFrom, tTo, vTo)...)
+			return diags
+
+		case reflect.Ptr:
+			switch tMapElem.Elem().Kind() {
+			case reflect.String:
+				//
+				// map[string]*string -> types.Map(OfString).
+				//
+				diags.Append(flattener.mapOfString(ctx, vFrom, tTo, vTo)...)
 				return diags
 			}
+		}
+	}
 
-			vTo.Set(reflect.ValueOf(to))
+	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+		"from": vFrom.Kind(),
+		"to":   tTo,
+	})
+
+	return diags
+}
+
+// struct_ copies an AWS API struct value to a compatible Plugin Framework value.
+func (flattener autoFlattener) struct_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.Type() == timeType {
+		switch tTo.(type) {
+		case fwtypes.TimestampType:
+			//
+			// time.Time -> fwtypes.Timestamp.
+			//
+			vTo.Set(reflect.ValueOf(fwtypes.NewTimestampValue(vFrom.Interface().(time.Time))))
 			return diags
+		}
+	}
 
-		case basetypes.SetTypable:
+	if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
+		//
+		// struct -> types.List(OfObject).
+		//
+		ptr := reflect.New(vFrom.Type())
+		ptr.Elem().Set(vFrom)
+		diags.Append(flattener.ptrToStructNestedObject(ctx, ptr, tTo, vTo)...)
+		return diags
+	}
+
+	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+		"from": vFrom.Type(),
+		"to":   tTo,
+	})
+
+	return diags
+}
+
+// interface_ copies an AWS API interface value to a compatible Plugin Framework value.
+func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if tMembers, ok := flattener.options.unions[vFrom.Type()]; ok {
+		if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
 			//
-			// []string -> types.Set(OfString).
+			// union interface -> types.List(OfObject).
 			//
-			if vFrom.IsNil() {
-				vTo.Set(reflect.ValueOf(types.SetNull(types.StringType)))
-				return diags
-			}
+			diags.Append(flattener.unionToNestedObject(ctx, vFrom, tMembers, tTo, vTo)...)
+			return diags
+		}
+	}
 
-			from := vFrom.Interface().([]string)
-			elements := make([]attr.Value, len(from))
-			for i, v := range from {
-				elements[i] = types.StringValue(v)
+	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+		"from": vFrom.Type(),
+		"to":   tTo,
+	})
+
+	return diags
+}
+
+// unionToNestedObject copies an AWS API union interface value to a compatible Plugin Framework NestedObjectValue value.
+// The nested Object field corresponding to the union's member is set and all other member fields are null.
+func (flattener autoFlattener) unionToNestedObject(ctx context.Context, vFrom reflect.Value, tMembers []reflect.Type, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tUnion := vFrom.Type()
+	var tMember reflect.Type
+	if !vFrom.IsNil() {
+		for _, t := range tMembers {
+			if vFrom.Elem().Type() == t {
+				tMember = t
+				break
 			}
-			set, d := types.SetValue(types.StringType, elements)
-			diags.Append(d...)
+		}
+
+		if tMember == nil {
+			tflog.Warn(ctx, "AutoFlex Flatten; unknown union member", map[string]interface{}{
+				"union":  tUnion,
+				"member": vFrom.Elem().Type(),
+			})
+		}
+	}
+
+	if tMember == nil || vFrom.Elem().IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target structure and set its member fields.
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to).Elem()
+	for _, tOther := range tMembers {
+		memberName := unionMemberName(tUnion, tOther)
+		fieldVal := t.FieldByName(memberName)
+		if !fieldVal.IsValid() || !fieldVal.CanSet() {
+			continue // Corresponding field not found in to.
+		}
+
+		if tOther == tMember {
+			diags.Append(flattener.convert(ctx, vFrom.Elem().Elem().FieldByName("Value"), fieldVal)...)
 			if diags.HasError() {
+				diags.AddError("AutoFlEx", fmt.Sprintf("union member (%s)", memberName))
 				return diags
 			}
 
-			to, d := tTo.ValueFromSet(ctx, set)
+			continue
+		}
+
+		// Custom nested Object types have their own null value.
+		v, ok := fieldVal.Interface().(attr.Value)
+		if !ok {
+			continue
+		}
+		if tField, ok := v.Type(ctx).(fwtypes.NestedObjectType); ok {
+			val, d := tField.NullValue(ctx)
 			diags.Append(d...)
 			if diags.HasError() {
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(to))
-			return diags
+			fieldVal.Set(reflect.ValueOf(val))
 		}
+	}
 
-	case reflect.Ptr:
-		switch tSliceElem.Elem().Kind() {
-		case reflect.String:
-			switch tTo := tTo.(type) {
-			case basetypes.ListTypable:
-				//
-				// []*string -> types.List(OfString).
-				//
-				if vFrom.IsNil() {
-					vTo.Set(reflect.ValueOf(types.ListNull(types.StringType)))
-					return diags
-				}
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectPtr(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
 
-				from := vFrom.Interface().([]*string)
-				elements := make([]attr.Value, len(from))
-				for i, v := range from {
-					elements[i] = types.StringPointerValue(v)
-				}
-				list, d := types.ListValue(types.StringType, elements)
-				diags.Append(d...)
-				if diags.HasError() {
-					return diags
-				}
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
 
-				to, d := tTo.ValueFromList(ctx, list)
-				diags.Append(d...)
-				if diags.HasError() {
-					return diags
-				}
+// sliceOfString copies an AWS API []string, []*string or slice of string-based enum value to a compatible Plugin Framework value.
+// The target's element type is used if it is known, e.g. a list of fwtypes.ARN, otherwise types.String.
+func (flattener autoFlattener) sliceOfString(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-				vTo.Set(reflect.ValueOf(to))
+	tElem := stringElementType(tTo)
+
+	switch tTo := tTo.(type) {
+	case basetypes.ListTypable:
+		if vFrom.IsNil() {
+			vTo.Set(reflect.ValueOf(types.ListNull(tElem)))
+			return diags
+		}
+
+		elements := make([]attr.Value, vFrom.Len())
+		for i := range elements {
+			v, d := stringElementValue(ctx, tElem, vFrom.Index(i))
+			diags.Append(d...)
+			if diags.HasError() {
 				return diags
+			}
 
-			case basetypes.SetTypable:
-				//
-				// []string -> types.Set(OfString).
-				//
-				if vFrom.IsNil() {
-					vTo.Set(reflect.ValueOf(types.SetNull(types.StringType)))
-					return diags
-				}
+			elements[i] = v
+		}
+		list, d := types.ListValue(tElem, elements)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
 
-				from := vFrom.Interface().([]*string)
-				elements := make([]attr.Value, len(from))
-				for i, v := range from {
-					elements[i] = types.StringPointerValue(v)
-				}
-				set, d := types.SetValue(types.StringType, elements)
-				diags.Append(d...)
-				if diags.HasError() {
-					return diags
-				}
+		to, d := tTo.ValueFromList(ctx, list)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
 
-				to, d := tTo.ValueFromSet(ctx, set)
-				diags.Append(d...)
-				if diags.HasError() {
-					return diags
-				}
+		vTo.Set(reflect.ValueOf(to))
+		return diags
 
-				vTo.Set(reflect.ValueOf(to))
-				return diags
-			}
+	case basetypes.SetTypable:
+		if vFrom.IsNil() {
+			vTo.Set(reflect.ValueOf(types.SetNull(tElem)))
+			return diags
+		}
 
-		case reflect.Struct:
-			if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
-				//
-				// []*struct -> types.List(OfObject).
-				//
-				diags.Append(flattener.sliceOfStructNestedObject(ctx, vFrom, tTo, vTo)...)
+		elements := make([]attr.Value, vFrom.Len())
+		for i := range elements {
+			v, d := stringElementValue(ctx, tElem, vFrom.Index(i))
+			diags.Append(d...)
+			if diags.HasError() {
 				return diags
 			}
+
+			elements[i] = v
+		}
+		set, d := types.SetValue(tElem, elements)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
 		}
 
-	case reflect.Struct:
-		if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
-			//
-			// []struct -> types.List(OfObject).
-			//
-			diags.Append(flattener.sliceOfStructNestedObject(ctx, vFrom, tTo, vTo)...)
+		to, d := tTo.ValueFromSet(ctx, set)
+		diags.Append(d...)
+		if diags.HasError() {
 			return diags
 		}
+
+		vTo.Set(reflect.ValueOf(to))
+		return diags
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -1069,80 +1478,44 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 	return diags
 }
 
-// map_ copies an AWS API map value to a compatible Plugin Framework value.
-func (flattener autoFlattener) map_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+// mapOfString copies an AWS API map[string]string, map[string]*string or map of string-based enum value to a compatible Plugin Framework value.
+// The target's element type is used if it is known, otherwise types.String.
+func (flattener autoFlattener) mapOfString(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	switch tMapKey := vFrom.Type().Key(); tMapKey.Kind() {
-	case reflect.String:
-		switch tMapElem := vFrom.Type().Elem(); tMapElem.Kind() {
-		case reflect.String:
-			switch tTo := tTo.(type) {
-			case basetypes.MapTypable:
-				//
-				// map[string]string -> types.Map(OfString).
-				//
-				if vFrom.IsNil() {
-					vTo.Set(reflect.ValueOf(types.MapNull(types.StringType)))
-					return diags
-				}
+	tElem := stringElementType(tTo)
 
-				from := vFrom.Interface().(map[string]string)
-				elements := make(map[string]attr.Value, len(from))
-				for k, v := range from {
-					elements[k] = types.StringValue(v)
-				}
-				map_, d := types.MapValue(types.StringType, elements)
-				diags.Append(d...)
-				if diags.HasError() {
-					return diags
-				}
-
-				to, d := tTo.ValueFromMap(ctx, map_)
-				diags.Append(d...)
-				if diags.HasError() {
-					return diags
-				}
+	switch tTo := tTo.(type) {
+	case basetypes.MapTypable:
+		if vFrom.IsNil() {
+			vTo.Set(reflect.ValueOf(types.MapNull(tElem)))
+			return diags
+		}
 
-				vTo.Set(reflect.ValueOf(to))
+		elements := make(map[string]attr.Value, vFrom.Len())
+		for iter := vFrom.MapRange(); iter.Next(); {
+			v, d := stringElementValue(ctx, tElem, iter.Value())
+			diags.Append(d...)
+			if diags.HasError() {
 				return diags
 			}
 
-		case reflect.Ptr:
-			switch tMapElem.Elem().Kind() {
-			case reflect.String:
-				switch tTo := tTo.(type) {
-				case basetypes.MapTypable:
-					//
-					// map[string]*string -> types.Map(OfString).
-					//
-					if vFrom.IsNil() {
-						vTo.Set(reflect.ValueOf(types.MapNull(types.StringType)))
-						return diags
-					}
-
-					from := vFrom.Interface().(map[string]*string)
-					elements := make(map[string]attr.Value, len(from))
-					for k, v := range from {
-						elements[k] = types.StringPointerValue(v)
-					}
-					map_, d := types.MapValue(types.StringType, elements)
-					diags.Append(d...)
-					if diags.HasError() {
-						return diags
-					}
-
-					to, d := tTo.ValueFromMap(ctx, map_)
-					diags.Append(d...)
-					if diags.HasError() {
-						return diags
-					}
+			elements[iter.Key().String()] = v
+		}
+		map_, d := types.MapValue(tElem, elements)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
 
-					vTo.Set(reflect.ValueOf(to))
-					return diags
-				}
-			}
+		to, d := tTo.ValueFromMap(ctx, map_)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
 		}
+
+		vTo.Set(reflect.ValueOf(to))
+		return diags
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -1240,3 +1613,59 @@ func (flattener autoFlattener) sliceOfStructNestedObject(ctx context.Context, vF
 	vTo.Set(reflect.ValueOf(val))
 	return diags
 }
+
+// stringSliceValue returns the specified strings as a value of the specified slice type,
+// whose elements are strings or string-based enums.
+func stringSliceValue(tSlice reflect.Type, from []string) reflect.Value {
+	if from == nil {
+		return reflect.Zero(tSlice)
+	}
+
+	to := reflect.MakeSlice(tSlice, len(from), len(from))
+	for i, v := range from {
+		to.Index(i).SetString(v)
+	}
+
+	return to
+}
+
+// stringMapValue returns the specified string map as a value of the specified map type,
+// whose elements are strings or string-based enums.
+func stringMapValue(tMap reflect.Type, from map[string]string) reflect.Value {
+	if from == nil {
+		return reflect.Zero(tMap)
+	}
+
+	to := reflect.MakeMapWithSize(tMap, len(from))
+	for k, v := range from {
+		elem := reflect.New(tMap.Elem()).Elem()
+		elem.SetString(v)
+		to.SetMapIndex(reflect.ValueOf(k), elem)
+	}
+
+	return to
+}
+
+// stringElementType returns the String(ish) element type of the specified aggregate type, defaulting to types.String.
+func stringElementType(typ attr.Type) basetypes.StringTypable {
+	if typ, ok := typ.(attr.TypeWithElementType); ok {
+		if typ, ok := typ.ElementType().(basetypes.StringTypable); ok {
+			return typ
+		}
+	}
+
+	return types.StringType
+}
+
+// stringElementValue returns the specified string, *string or string-based enum value as a value of the specified element type.
+func stringElementValue(ctx context.Context, tElem basetypes.StringTypable, v reflect.Value) (attr.Value, diag.Diagnostics) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return tElem.ValueFromString(ctx, types.StringNull())
+		}
+
+		v = v.Elem()
+	}
+
+	return tElem.ValueFromString(ctx, types.StringValue(v.String()))
+}
@@ -6,8 +6,10 @@ package flex
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -120,6 +122,96 @@ type TestFlexAWS09 struct {
 	Field4 []TestFlexAWS03
 }
 
+// Custom types.
+type TestFlexTF08 struct {
+	Field1 fwtypes.ARN            `tfsdk:"field1"`
+	Field2 fwtypes.CIDRBlock      `tfsdk:"field2"`
+	Field3 fwtypes.Duration       `tfsdk:"field3"`
+	Field4 fwtypes.Duration       `tfsdk:"field4"`
+	Field5 fwtypes.TimestampValue `tfsdk:"field5"`
+	Field6 fwtypes.TimestampValue `tfsdk:"field6"`
+	Field7 types.String           `tfsdk:"field7"`
+	Field8 types.String           `tfsdk:"field8"`
+}
+
+// List/Set/Map of enums and custom types.
+type TestFlexTF09 struct {
+	Field1 types.List `tfsdk:"field1"`
+	Field2 types.Set  `tfsdk:"field2"`
+	Field3 types.Map  `tfsdk:"field3"`
+	Field4 types.List `tfsdk:"field4"`
+}
+
+type TestEnum string
+
+const (
+	TestEnumFoo TestEnum = "Foo"
+	TestEnumBar TestEnum = "Bar"
+)
+
+type TestFlexAWS10 struct {
+	Field1 *string
+	Field2 string
+	Field3 time.Duration
+	Field4 *string
+	Field5 time.Time
+	Field6 *time.Time
+	Field7 TestEnum
+	Field8 *TestEnum
+}
+
+type TestFlexAWS11 struct {
+	Field1 []TestEnum
+	Field2 []TestEnum
+	Field3 map[string]TestEnum
+	Field4 []string
+}
+
+// Field name mapping and ignored fields.
+type TestFlexTF10 struct {
+	Name   types.String `tfsdk:"name"`
+	Field2 types.String `tfsdk:"field2"`
+	Field3 types.String `tfsdk:"field3"`
+}
+
+type TestFlexAWS12 struct {
+	PolicyName *string
+	Field2     *string
+	Field3     *string
+}
+
+// Union (oneOf) types.
+type TestFlexUnion interface {
+	isTestFlexUnion()
+}
+
+type TestFlexUnionMemberFoo struct {
+	Value TestFlexAWS01
+}
+
+func (*TestFlexUnionMemberFoo) isTestFlexUnion() {}
+
+type TestFlexUnionMemberBar struct {
+	Value string
+}
+
+func (*TestFlexUnionMemberBar) isTestFlexUnion() {}
+
+type TestFlexTF11 struct {
+	Foo fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"foo"`
+	Bar types.String                                  `tfsdk:"bar"`
+}
+
+type TestFlexTF12 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexTF11] `tfsdk:"field1"`
+}
+
+type TestFlexAWS13 struct {
+	Field1 TestFlexUnion
+}
+
+var testFlexUnionOption = WithUnion[TestFlexUnion](&TestFlexUnionMemberFoo{}, &TestFlexUnionMemberBar{})
+
 func TestGenericExpand(t *testing.T) {
 	t.Parallel()
 
@@ -128,6 +220,7 @@ func TestGenericExpand(t *testing.T) {
 	testStringResult := "a"
 	testCases := []struct {
 		TestName   string
+		Options    []AutoFlexOptionsFunc
 		Source     any
 		Target     any
 		WantErr    bool
@@ -396,6 +489,126 @@ func TestGenericExpand(t *testing.T) {
 				Field4: []TestFlexAWS03{{Field1: 100}, {Field1: 2000}, {Field1: 30000}},
 			},
 		},
+		{
+			TestName: "custom types Source and compatible Target",
+			Source: &TestFlexTF08{
+				Field1: fwtypes.ARNValue(testARN),
+				Field2: fwtypes.CIDRBlockValue("10.0.0.0/16"),
+				Field3: fwtypes.DurationValue(90 * time.Minute),
+				Field4: fwtypes.DurationValue(time.Hour),
+				Field5: fwtypes.NewTimestampValue(testTimeTime),
+				Field6: fwtypes.NewTimestampValue(testTimeTime),
+				Field7: types.StringValue("Foo"),
+				Field8: types.StringValue("Bar"),
+			},
+			Target: &TestFlexAWS10{},
+			WantTarget: &TestFlexAWS10{
+				Field1: aws.String(testARNString),
+				Field2: "10.0.0.0/16",
+				Field3: 90 * time.Minute,
+				Field4: aws.String("1h0m0s"),
+				Field5: testTimeTime,
+				Field6: aws.Time(testTimeTime),
+				Field7: TestEnumFoo,
+				Field8: testEnumPtr(TestEnumBar),
+			},
+		},
+		{
+			TestName:   "null custom types Source and compatible Target",
+			Source:     &TestFlexTF08{},
+			Target:     &TestFlexAWS10{},
+			WantTarget: &TestFlexAWS10{},
+		},
+		{
+			TestName: "list/set/map of enums and custom types Source and compatible Target",
+			Source: &TestFlexTF09{
+				Field1: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("Foo"),
+					types.StringValue("Bar"),
+				}),
+				Field2: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("Foo"),
+				}),
+				Field3: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"X": types.StringValue("Bar"),
+				}),
+				Field4: types.ListValueMust(fwtypes.ARNType, []attr.Value{
+					fwtypes.ARNValue(testARN),
+				}),
+			},
+			Target: &TestFlexAWS11{},
+			WantTarget: &TestFlexAWS11{
+				Field1: []TestEnum{TestEnumFoo, TestEnumBar},
+				Field2: []TestEnum{TestEnumFoo},
+				Field3: map[string]TestEnum{"X": TestEnumBar},
+				Field4: []string{testARNString},
+			},
+		},
+		{
+			TestName: "mapped and ignored fields",
+			Options:  []AutoFlexOptionsFunc{WithFieldNameMapping("Name", "PolicyName"), WithIgnoredFieldNames("Field3")},
+			Source: &TestFlexTF10{
+				Name:   types.StringValue("a"),
+				Field2: types.StringValue("b"),
+				Field3: types.StringValue("c"),
+			},
+			Target: &TestFlexAWS12{},
+			WantTarget: &TestFlexAWS12{
+				PolicyName: aws.String("a"),
+				Field2:     aws.String("b"),
+			},
+		},
+		{
+			TestName: "union nested block member Source and union Target",
+			Options:  []AutoFlexOptionsFunc{testFlexUnionOption},
+			Source: &TestFlexTF12{
+				Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF11{
+					Foo: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+					Bar: types.StringNull(),
+				}),
+			},
+			Target: &TestFlexAWS13{},
+			WantTarget: &TestFlexAWS13{
+				Field1: &TestFlexUnionMemberFoo{Value: TestFlexAWS01{Field1: "a"}},
+			},
+		},
+		{
+			TestName: "union primitive member Source and union Target",
+			Options:  []AutoFlexOptionsFunc{testFlexUnionOption},
+			Source: &TestFlexTF12{
+				Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF11{
+					Foo: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Bar: types.StringValue("b"),
+				}),
+			},
+			Target: &TestFlexAWS13{},
+			WantTarget: &TestFlexAWS13{
+				Field1: &TestFlexUnionMemberBar{Value: "b"},
+			},
+		},
+		{
+			TestName: "union multiple members Source and union Target",
+			Options:  []AutoFlexOptionsFunc{testFlexUnionOption},
+			Source: &TestFlexTF12{
+				Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF11{
+					Foo: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+					Bar: types.StringValue("b"),
+				}),
+			},
+			Target:  &TestFlexAWS13{},
+			WantErr: true,
+		},
+		{
+			TestName: "union unregistered Source and union Target",
+			Source: &TestFlexTF12{
+				Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF11{
+					Foo: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Bar: types.StringValue("b"),
+				}),
+			},
+			Target:  &TestFlexAWS13{},
+			WantErr: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -403,7 +616,7 @@ func TestGenericExpand(t *testing.T) {
 		t.Run(testCase.TestName, func(t *testing.T) {
 			t.Parallel()
 
-			err := Expand(ctx, testCase.Source, testCase.Target)
+			err := Expand(ctx, testCase.Source, testCase.Target, testCase.Options...)
 			gotErr := err != nil
 
 			if gotErr != testCase.WantErr {
@@ -428,6 +641,7 @@ func TestGenericFlatten(t *testing.T) {
 	testString := "test"
 	testCases := []struct {
 		TestName   string
+		Options    []AutoFlexOptionsFunc
 		Source     any
 		Target     any
 		WantErr    bool
@@ -753,6 +967,136 @@ func TestGenericFlatten(t *testing.T) {
 				}),
 			},
 		},
+		{
+			TestName: "custom types Source and compatible Target",
+			Source: &TestFlexAWS10{
+				Field1: aws.String(testARNString),
+				Field2: "10.0.0.0/16",
+				Field3: 90 * time.Minute,
+				Field4: aws.String("1h0m0s"),
+				Field5: testTimeTime,
+				Field6: aws.Time(testTimeTime),
+				Field7: TestEnumFoo,
+				Field8: testEnumPtr(TestEnumBar),
+			},
+			Target: &TestFlexTF08{},
+			WantTarget: &TestFlexTF08{
+				Field1: fwtypes.ARNValue(testARN),
+				Field2: fwtypes.CIDRBlockValue("10.0.0.0/16"),
+				Field3: fwtypes.DurationValue(90 * time.Minute),
+				Field4: fwtypes.DurationValue(time.Hour),
+				Field5: fwtypes.NewTimestampValue(testTimeTime),
+				Field6: fwtypes.NewTimestampValue(testTimeTime),
+				Field7: types.StringValue("Foo"),
+				Field8: types.StringValue("Bar"),
+			},
+		},
+		{
+			TestName: "nil custom types Source and compatible Target",
+			Source: &TestFlexAWS10{
+				Field5: testTimeTime,
+			},
+			Target: &TestFlexTF08{},
+			WantTarget: &TestFlexTF08{
+				Field1: fwtypes.ARNNull(),
+				Field2: fwtypes.CIDRBlockValue(""),
+				Field3: fwtypes.DurationValue(0),
+				Field4: fwtypes.DurationNull(),
+				Field5: fwtypes.NewTimestampValue(testTimeTime),
+				Field6: fwtypes.NewTimestampNull(),
+				Field7: types.StringValue(""),
+				Field8: types.StringNull(),
+			},
+		},
+		{
+			TestName: "slices/map of enums Source and compatible Target",
+			Source: &TestFlexAWS11{
+				Field1: []TestEnum{TestEnumFoo, TestEnumBar},
+				Field2: []TestEnum{TestEnumFoo},
+				Field3: map[string]TestEnum{"X": TestEnumBar},
+				Field4: []string{testARNString},
+			},
+			Target: &TestFlexTF09{
+				Field4: types.ListNull(fwtypes.ARNType),
+			},
+			WantTarget: &TestFlexTF09{
+				Field1: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("Foo"),
+					types.StringValue("Bar"),
+				}),
+				Field2: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("Foo"),
+				}),
+				Field3: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"X": types.StringValue("Bar"),
+				}),
+				Field4: types.ListValueMust(fwtypes.ARNType, []attr.Value{
+					fwtypes.ARNValue(testARN),
+				}),
+			},
+		},
+		{
+			TestName: "nil slices/map of enums Source and compatible Target",
+			Source:   &TestFlexAWS11{},
+			Target:   &TestFlexTF09{},
+			WantTarget: &TestFlexTF09{
+				Field1: types.ListNull(types.StringType),
+				Field2: types.SetNull(types.StringType),
+				Field3: types.MapNull(types.StringType),
+				Field4: types.ListNull(types.StringType),
+			},
+		},
+		{
+			TestName: "mapped and ignored fields",
+			Options:  []AutoFlexOptionsFunc{WithFieldNameMapping("Name", "PolicyName"), WithIgnoredFieldNames("Field3")},
+			Source: &TestFlexAWS12{
+				PolicyName: aws.String("a"),
+				Field2:     aws.String("b"),
+				Field3:     aws.String("c"),
+			},
+			Target: &TestFlexTF10{},
+			WantTarget: &TestFlexTF10{
+				Name:   types.StringValue("a"),
+				Field2: types.StringValue("b"),
+			},
+		},
+		{
+			TestName: "union nested block member Source and union Target",
+			Options:  []AutoFlexOptionsFunc{testFlexUnionOption},
+			Source: &TestFlexAWS13{
+				Field1: &TestFlexUnionMemberFoo{Value: TestFlexAWS01{Field1: "a"}},
+			},
+			Target: &TestFlexTF12{},
+			WantTarget: &TestFlexTF12{
+				Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF11{
+					Foo: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+					Bar: types.StringNull(),
+				}),
+			},
+		},
+		{
+			TestName: "union primitive member Source and union Target",
+			Options:  []AutoFlexOptionsFunc{testFlexUnionOption},
+			Source: &TestFlexAWS13{
+				Field1: &TestFlexUnionMemberBar{Value: "b"},
+			},
+			Target: &TestFlexTF12{},
+			WantTarget: &TestFlexTF12{
+				Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF11{
+					Foo: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Bar: types.StringValue("b"),
+				}),
+			},
+		},
+		{
+			TestName: "nil union Source and union Target",
+			Options:  []AutoFlexOptionsFunc{testFlexUnionOption},
+			Source:   &TestFlexAWS13{},
+			Target:   &TestFlexTF12{},
+			WantTarget: &TestFlexTF12{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF11](ctx),
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -760,7 +1104,7 @@ func TestGenericFlatten(t *testing.T) {
 		t.Run(testCase.TestName, func(t *testing.T) {
 			t.Parallel()
 
-			err := Flatten(ctx, testCase.Source, testCase.Target)
+			err := Flatten(ctx, testCase.Source, testCase.Target, testCase.Options...)
 			gotErr := err != nil
 
 			if gotErr != testCase.WantErr {
@@ -777,3 +1121,13 @@ func TestGenericFlatten(t *testing.T) {
 		})
 	}
 }
+
+var (
+	testARNString = "arn:aws:iam::123456789012:role/example"
+	testARN       = arn.ARN{Partition: "aws", Service: "iam", AccountID: "123456789012", Resource: "role/example"}
+	testTimeTime  = time.Date(2023, time.September, 1, 12, 30, 0, 0, time.UTC)
+)
+
+func testEnumPtr(v TestEnum) *TestEnum {
+	return &v
+}
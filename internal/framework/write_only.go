@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WriteOnlyStringValue returns the configured value of the specified write-only string attribute.
+// It is the Plugin Framework equivalent of sdkv2.GetWriteOnlyString.
+// Resources typically call this during Create, and during Update when the attribute's companion version attribute changes.
+// The Plugin Framework has no write-only attributes, so the value is still planned and must be declared Sensitive.
+func WriteOnlyStringValue(ctx context.Context, config tfsdk.Config, path path.Path) (types.String, diag.Diagnostics) {
+	var value types.String
+
+	diags := config.GetAttribute(ctx, path, &value)
+
+	return value, diags
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+func TestWriteOnlyStringValue(t *testing.T) {
+	t.Parallel()
+
+	configSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional: true,
+			},
+		},
+	}
+	configType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"password_wo":         tftypes.String,
+			"password_wo_version": tftypes.Number,
+		},
+	}
+
+	tests := map[string]struct {
+		val         tftypes.Value
+		path        path.Path
+		expected    types.String
+		expectError bool
+	}{
+		"null value": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			path:     path.Root("password_wo"),
+			expected: types.StringNull(),
+		},
+		"unknown value": {
+			val:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			path:     path.Root("password_wo"),
+			expected: types.StringUnknown(),
+		},
+		"configured value": {
+			val:      tftypes.NewValue(tftypes.String, "s3cr3t"),
+			path:     path.Root("password_wo"),
+			expected: types.StringValue("s3cr3t"),
+		},
+		"not in schema": {
+			val:         tftypes.NewValue(tftypes.String, "s3cr3t"),
+			path:        path.Root("secret_wo"),
+			expected:    types.StringNull(),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			config := tfsdk.Config{
+				Raw: tftypes.NewValue(configType, map[string]tftypes.Value{
+					"password_wo":         test.val,
+					"password_wo_version": tftypes.NewValue(tftypes.Number, 1),
+				}),
+				Schema: configSchema,
+			}
+
+			got, diags := framework.WriteOnlyStringValue(ctx, config, test.path)
+
+			if got, want := diags.HasError(), test.expectError; got != want {
+				t.Fatalf("diags.HasError() = %t, want %t: %v", got, want, diags)
+			}
+
+			if !got.Equal(test.expected) {
+				t.Errorf("got %s, expected %s", got, test.expected)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 func main() {
 	const (
 		filename      = `service_package_gen.go`
+		sweepFilename = `sweep_gen.go`
 		namesDataFile = `../../../names/names_data.csv`
 	)
 	g := common.NewGenerator()
@@ -67,6 +68,7 @@ func main() {
 
 			frameworkDataSources: make([]ResourceDatum, 0),
 			frameworkResources:   make([]ResourceDatum, 0),
+			listResources:        make(map[string]ResourceDatum),
 			sdkDataSources:       make(map[string]ResourceDatum),
 			sdkResources:         make(map[string]ResourceDatum),
 		}
@@ -85,6 +87,7 @@ func main() {
 			ProviderNameUpper:    l[names.ColProviderNameUpper],
 			FrameworkDataSources: v.frameworkDataSources,
 			FrameworkResources:   v.frameworkResources,
+			ListResources:        v.listResources,
 			SDKDataSources:       v.sdkDataSources,
 			SDKResources:         v.sdkResources,
 		}
@@ -118,6 +121,23 @@ func main() {
 			g.Fatalf("generating file (%s): %s", filename, err)
 		}
 
+		// Framework resource sweepers are generated from @Sweeper and @NoSweeper annotations.
+		if slices.ContainsFunc(s.FrameworkResources, func(d ResourceDatum) bool { return d.SweeperLister != "" || d.SweeperSkipReason != "" }) {
+			g.Infof("Generating internal/service/%s/%s", servicePackage, sweepFilename)
+
+			d := g.NewGoFileDestination(sweepFilename)
+
+			if err := d.WriteTemplate("sweep", sweepTmpl, s); err != nil {
+				g.Fatalf("error generating %s sweepers: %s", p, err)
+			}
+
+			if err := d.Write(); err != nil {
+				g.Fatalf("generating file (%s): %s", sweepFilename, err)
+			}
+		} else if err := os.Remove(sweepFilename); err != nil && !os.IsNotExist(err) {
+			g.Fatalf("removing file (%s): %s", sweepFilename, err)
+		}
+
 		break
 	}
 }
@@ -128,6 +148,10 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	TagsAlwaysUpdate        bool
+	SweeperLister           string
+	SweeperDependencies     []string
+	SweeperSkipReason       string
 }
 
 type ServiceDatum struct {
@@ -140,6 +164,7 @@ type ServiceDatum struct {
 	ProviderNameUpper    string
 	FrameworkDataSources []ResourceDatum
 	FrameworkResources   []ResourceDatum
+	ListResources        map[string]ResourceDatum
 	SDKDataSources       map[string]ResourceDatum
 	SDKResources         map[string]ResourceDatum
 }
@@ -147,6 +172,9 @@ type ServiceDatum struct {
 //go:embed file.tmpl
 var tmpl string
 
+//go:embed sweep.tmpl
+var sweepTmpl string
+
 // Annotation processing.
 var (
 	annotation = regexache.MustCompile(`^//\s*@([a-zA-Z0-9]+)(\(([^)]*)\))?\s*$`)
@@ -162,6 +190,7 @@ type visitor struct {
 
 	frameworkDataSources []ResourceDatum
 	frameworkResources   []ResourceDatum
+	listResources        map[string]ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
 }
@@ -201,7 +230,8 @@ func (v *visitor) processFile(file *ast.File) {
 }
 
 // processFuncDecl processes a single Go function.
-// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source,
+// or a list resource.
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
@@ -227,7 +257,44 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			if attr, ok := args.Keyword["resourceType"]; ok {
 				d.TagsResourceType = attr
 			}
+
+			if attr, ok := args.Keyword["alwaysUpdate"]; ok {
+				d.TagsAlwaysUpdate = attr == "true"
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Sweeper" {
+			args := common.ParseArgs(m[3])
+
+			attr, ok := args.Keyword["lister"]
+
+			if !ok {
+				v.err = multierror.Append(v.err, fmt.Errorf("no sweeper lister: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			d.SweeperLister = attr
+
+			// Dependencies are separated by semicolons as commas separate annotation arguments.
+			if attr, ok := args.Keyword["dependencies"]; ok {
+				d.SweeperDependencies = strings.Split(attr, ";")
+			}
 		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "NoSweeper" {
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				v.err = multierror.Append(v.err, fmt.Errorf("no reason for NoSweeper: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			d.SweeperSkipReason = args.Positional[0]
+		}
+	}
+
+	if d.SweeperLister != "" && d.SweeperSkipReason != "" {
+		v.err = multierror.Append(v.err, fmt.Errorf("both Sweeper and NoSweeper annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 	}
 
 	for _, line := range funcDecl.Doc.List {
@@ -242,6 +309,11 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.Name = attr
 			}
 
+			if (d.SweeperLister != "" || d.SweeperSkipReason != "") && slices.Contains([]string{"FrameworkDataSource", "ListResource", "SDKDataSource", "SDKResource"}, m[1]) {
+				v.err = multierror.Append(v.err, fmt.Errorf("Sweeper and NoSweeper annotations are only supported for Framework Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
 			switch annotationName := m[1]; annotationName {
 			case "FrameworkDataSource":
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
@@ -255,6 +327,19 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.frameworkResources = append(v.frameworkResources, d)
 				}
+			case "ListResource":
+				if len(args.Positional) == 0 {
+					v.err = multierror.Append(v.err, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if _, ok := v.listResources[typeName]; ok {
+					v.err = multierror.Append(v.err, fmt.Errorf("duplicate List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.listResources[typeName] = d
+				}
 			case "SDKDataSource":
 				if len(args.Positional) == 0 {
 					v.err = multierror.Append(v.err, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -281,7 +366,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "NoSweeper", "Sweeper", "Tags":
 				// Handled above.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
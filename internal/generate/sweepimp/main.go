@@ -71,7 +71,7 @@ func main() {
 			continue
 		}
 
-		if _, err := os.Stat(fmt.Sprintf("../service/%s/sweep.go", p)); err != nil || errors.Is(err, fs.ErrNotExist) {
+		if !hasSweepers(p) {
 			continue
 		}
 
@@ -99,3 +99,14 @@ func main() {
 
 //go:embed file.tmpl
 var tmpl string
+
+// hasSweepers returns whether the service package registers sweepers, either by hand or generated from annotations.
+func hasSweepers(p string) bool {
+	for _, filename := range []string{"sweep.go", "sweep_gen.go"} {
+		if _, err := os.Stat(fmt.Sprintf("../service/%s/%s", p, filename)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -8,17 +8,23 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // A data source interceptor is functionality invoked during the data source's CRUD request lifecycle.
@@ -99,8 +105,17 @@ const (
 
 // interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
 func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDResponse](interceptors []resourceInterceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
-	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
-		var diags diag.Diagnostics
+	return func(ctx context.Context, request Request, response *Response) (diags diag.Diagnostics) {
+		// Each handler invocation is traced if tracing is enabled.
+		ctx, span := startHandlerSpan(ctx, resourceCRUDOperation(request))
+		defer func() {
+			var id string
+			if tracing.Enabled() {
+				id = resourceCRUDID(ctx, request, response)
+			}
+			tracing.EndHandlerSpan(span, id, fwdiag.DiagnosticsError(diags))
+		}()
+
 		// Before interceptors are run first to last.
 		forward := interceptors
 
@@ -136,6 +151,59 @@ func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDRespon
 	}
 }
 
+// startHandlerSpan starts a tracing span for the specified CRUD handler of the data source or resource in Context.
+func startHandlerSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	var servicePackageName, typeName string
+	var isDataSource bool
+	if inContext, ok := conns.FromContext(ctx); ok {
+		servicePackageName, typeName, isDataSource = inContext.ServicePackageName, inContext.TypeName, inContext.IsDataSource
+	}
+
+	return tracing.StartHandlerSpan(ctx, servicePackageName, typeName, isDataSource, operation)
+}
+
+// resourceCRUDOperation returns the name of the CRUD operation for the specified request.
+func resourceCRUDOperation[Request resourceCRUDRequest](request Request) string {
+	switch any(request).(type) {
+	case resource.CreateRequest:
+		return "Create"
+	case resource.ReadRequest:
+		return "Read"
+	case resource.UpdateRequest:
+		return "Update"
+	case resource.DeleteRequest:
+		return "Delete"
+	default:
+		return ""
+	}
+}
+
+// resourceCRUDID returns the value of the resource's `id` attribute, if any, after the CRUD operation.
+func resourceCRUDID[Request resourceCRUDRequest, Response resourceCRUDResponse](ctx context.Context, request Request, response *Response) string {
+	var state tfsdk.State
+	switch v := any(response).(type) {
+	case *resource.CreateResponse:
+		state = v.State
+	case *resource.ReadResponse:
+		state = v.State
+	case *resource.UpdateResponse:
+		state = v.State
+	case *resource.DeleteResponse:
+		state = any(request).(resource.DeleteRequest).State
+	}
+
+	if state.Raw.IsNull() {
+		return ""
+	}
+
+	var id fwtypes.String
+	if diags := state.GetAttribute(ctx, path.Root(names.AttrID), &id); diags.HasError() {
+		return ""
+	}
+
+	return id.ValueString()
+}
+
 // contextFunc augments Context.
 type contextFunc func(context.Context, *conns.AWSClient) context.Context
 
@@ -143,15 +211,21 @@ type contextFunc func(context.Context, *conns.AWSClient) context.Context
 type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	inner            datasource.DataSourceWithConfigure
-	interceptors     dataSourceInterceptors
-	meta             *conns.AWSClient
+	// factory creates a new instance of the wrapped data source.
+	factory func(context.Context) (datasource.DataSourceWithConfigure, error)
+	inner   datasource.DataSourceWithConfigure
+	// innerSchema is the data source's own schema if the top-level `region` attribute has been injected.
+	innerSchema  *dsschema.Schema
+	interceptors dataSourceInterceptors
+	meta         *conns.AWSClient
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, factory func(context.Context) (datasource.DataSourceWithConfigure, error), inner datasource.DataSourceWithConfigure, innerSchema *dsschema.Schema, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
+		factory:          factory,
 		inner:            inner,
+		innerSchema:      innerSchema,
 		interceptors:     interceptors,
 	}
 }
@@ -164,15 +238,44 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.innerSchema != nil {
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]dsschema.Attribute)
+		}
+		response.Schema.Attributes[names.AttrRegion] = regionDataSourceAttribute()
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
+
+	ctx, span := startHandlerSpan(ctx, "Read")
+	defer func() {
+		var id string
+		if tracing.Enabled() && !response.State.Raw.IsNull() {
+			var v fwtypes.String
+			if diags := response.State.GetAttribute(ctx, path.Root(names.AttrID), &v); !diags.HasError() {
+				id = v.ValueString()
+			}
+		}
+		tracing.EndHandlerSpan(span, id, fwdiag.DiagnosticsError(response.Diagnostics))
+	}()
+
 	// TODO Run interceptors.
+	if w.innerSchema != nil {
+		w.readWithRegion(ctx, request, response)
+
+		return
+	}
+
 	w.inner.Read(ctx, request, response)
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Configure(ctx, request, response)
 }
@@ -191,15 +294,21 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	inner            resource.ResourceWithConfigure
-	interceptors     resourceInterceptors
-	meta             *conns.AWSClient
+	// factory creates a new instance of the wrapped resource.
+	factory func(context.Context) (resource.ResourceWithConfigure, error)
+	inner   resource.ResourceWithConfigure
+	// innerSchema is the resource's own schema if the top-level `region` attribute has been injected.
+	innerSchema  *rschema.Schema
+	interceptors resourceInterceptors
+	meta         *conns.AWSClient
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, factory func(context.Context) (resource.ResourceWithConfigure, error), inner resource.ResourceWithConfigure, innerSchema *rschema.Schema, interceptors resourceInterceptors) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
+		factory:          factory,
 		inner:            inner,
+		innerSchema:      innerSchema,
 		interceptors:     interceptors,
 	}
 }
@@ -212,10 +321,21 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.innerSchema != nil {
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]rschema.Attribute)
+		}
+		response.Schema.Attributes[names.AttrRegion] = regionResourceAttribute()
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+		if w.innerSchema != nil {
+			return w.createWithRegion(ctx, request, response)
+		}
+
 		w.inner.Create(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -226,6 +346,10 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+		if w.innerSchema != nil {
+			return w.readWithRegion(ctx, request, response)
+		}
+
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -236,6 +360,10 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+		if w.innerSchema != nil {
+			return w.updateWithRegion(ctx, request, response)
+		}
+
 		w.inner.Update(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -246,6 +374,10 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		if w.innerSchema != nil {
+			return w.deleteWithRegion(ctx, request, response)
+		}
+
 		w.inner.Delete(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -265,6 +397,12 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.innerSchema != nil {
+			w.importStateWithRegion(ctx, v, request, response)
+
+			return
+		}
+
 		v.ImportState(ctx, request, response)
 
 		return
@@ -277,6 +415,23 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if w.innerSchema != nil {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		w.modifyPlanRegion(ctx, request, response)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		setContextRegion(ctx, regionString(response.Plan.Raw))
+
+		if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+			w.modifyPlanWithRegion(ctx, v, request, response)
+		}
+
+		return
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
@@ -297,6 +452,12 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.innerSchema != nil {
+			w.validateConfigWithRegion(ctx, v, request, response)
+
+			return
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -304,6 +465,9 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.innerSchema != nil {
+			return w.upgradeStateWithRegion(v.UpgradeState(ctx))
+		}
 
 		return v.UpgradeState(ctx)
 	}
@@ -346,6 +510,13 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
 		tagsInContext.TagsIn = types.Some(tags)
+
+		// Check the effective tags against the account's tag policy.
+		diags.Append(tagPolicyComplianceDiags(ctx, meta, tags)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
 	case After:
 		// Set values for unknowns.
 		// Remove any provider configured ignore_tags and system tags from those passed to the service API.
@@ -527,6 +698,13 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 		}
 
 		if !newTagsAll.Equal(oldTagsAll) {
+			// Check the effective tags against the account's tag policy.
+			diags.Append(tagPolicyComplianceDiags(ctx, meta, tags)...)
+
+			if diags.HasError() {
+				return ctx, diags
+			}
+
 			if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
 				var identifier string
 
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// testTagsCreateRequest returns a CreateRequest whose plan has the specified tags.
+func testTagsCreateRequest(ctx context.Context, t *testing.T, tags map[string]string) resource.CreateRequest {
+	t.Helper()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Optional:    true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Computed:    true,
+			},
+		},
+	}
+
+	elems := make(map[string]tftypes.Value, len(tags))
+	for k, v := range tags {
+		elems[k] = tftypes.NewValue(tftypes.String, v)
+	}
+
+	raw := tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"tags":     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elems),
+		"tags_all": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+	})
+
+	return resource.CreateRequest{
+		Plan: tfsdk.Plan{
+			Raw:    raw,
+			Schema: s,
+		},
+	}
+}
+
+func TestTagsResourceInterceptorExcludeResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeName string
+		want     map[string]string
+	}{
+		"included": {
+			typeName: "aws_test",
+			want: map[string]string{
+				"tag1":        "value1",
+				"defaultkey1": "defaultvalue1",
+			},
+		},
+		"excluded": {
+			typeName: "aws_test_excluded",
+			want: map[string]string{
+				"tag1": "value1",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptor := tagsResourceInterceptor{
+				tags: &types.ServicePackageResourceTags{},
+			}
+
+			meta := &conns.AWSClient{
+				DefaultTagsConfig: &tftags.DefaultConfig{
+					Tags: tftags.New(context.Background(), map[string]string{
+						"defaultkey1": "defaultvalue1",
+					}),
+					ExcludeResourceTypes: []string{"aws_test_excluded"},
+				},
+			}
+
+			ctx := conns.NewResourceContext(context.Background(), "test", "Test", testCase.typeName)
+			ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResourceType(testCase.typeName), meta.IgnoreTagsConfig)
+
+			request := testTagsCreateRequest(ctx, t, map[string]string{"tag1": "value1"})
+
+			ctx, diags := interceptor.create(ctx, request, &resource.CreateResponse{}, meta, Before, nil)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			inContext, ok := tftags.FromContext(ctx)
+			if !ok {
+				t.Fatal("no tagging information in Context")
+			}
+
+			got := inContext.TagsIn.UnwrapOrDefault().Map()
+			if len(got) != len(testCase.want) {
+				t.Fatalf("TagsIn = %v, want %v", got, testCase.want)
+			}
+			for k, v := range testCase.want {
+				if got[k] != v {
+					t.Errorf("TagsIn[%q] = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestTagsResourceInterceptorTagPolicyCompliance(t *testing.T) {
+	t.Parallel()
+
+	// The configured tag "tag1" does not match the policy's capitalization.
+	policy, err := tftags.NewPolicy(`{"tags": {"tag1": {"tag_key": {"@@assign": "Tag1"}}}}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		compliance   string
+		wantSeverity diag.Severity
+		wantDiags    int
+	}{
+		"disabled": {
+			compliance: tftags.PolicyComplianceDisabled,
+		},
+		"warning": {
+			compliance:   tftags.PolicyComplianceWarning,
+			wantSeverity: diag.SeverityWarning,
+			wantDiags:    1,
+		},
+		"error": {
+			compliance:   tftags.PolicyComplianceError,
+			wantSeverity: diag.SeverityError,
+			wantDiags:    1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptor := tagsResourceInterceptor{
+				tags: &types.ServicePackageResourceTags{},
+			}
+
+			meta := &conns.AWSClient{
+				TagPolicyCompliance: testCase.compliance,
+			}
+			meta.SetEffectiveTagPolicy(policy)
+
+			ctx := conns.NewResourceContext(context.Background(), "test", "Test", "aws_test")
+			ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+
+			request := testTagsCreateRequest(ctx, t, map[string]string{"tag1": "value1"})
+
+			_, diags := interceptor.create(ctx, request, &resource.CreateResponse{}, meta, Before, nil)
+
+			if got, want := len(diags), testCase.wantDiags; got != want {
+				t.Fatalf("length of diags = %d, want %d: %v", got, want, diags)
+			}
+			for _, v := range diags {
+				if got, want := v.Severity(), testCase.wantSeverity; got != want {
+					t.Errorf("severity = %v, want %v", got, want)
+				}
+				if got, want := v.Summary(), "Tag policy violation"; got != want {
+					t.Errorf("summary = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
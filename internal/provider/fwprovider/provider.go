@@ -9,10 +9,13 @@ import (
 
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -51,6 +54,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"batch_reads": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Coalesce concurrent reads of individual resources into batched API calls where supported.\nReduces the number of API calls made when refreshing large numbers of resources.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -135,6 +142,13 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
 			},
+			"tag_policy_compliance": schema.StringAttribute{
+				Optional:    true,
+				Description: "The severity with which to enforce the account's effective AWS Organizations tag policy on resource tags before Create and Update. Valid values are `error`, `warning` and `disabled` (the default). Can also be configured using the `TF_AWS_TAG_POLICY_COMPLIANCE` environment variable.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(tftags.PolicyComplianceValues()...),
+				},
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -147,12 +161,28 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"validate_policies": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Validate IAM policy documents with IAM Access Analyzer during plan. ERROR and SECURITY_WARNING findings fail the plan.",
+			},
 		},
 		Blocks: map[string]schema.Block{
-			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
+			"api_rate_limits": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "The maximum sustained rate of requests, including retries, to the service's API.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. route53, whose API requests are rate limited. Service names are as in the endpoints block.",
+						},
+					},
 				},
+				Description: "Configuration blocks with client-side rate limits for AWS API requests to individual services.",
+			},
+			"assume_role": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -242,6 +272,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. aws_autoscaling_group, to which the default tags are not applied",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -316,7 +351,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 				}
@@ -325,12 +360,19 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 			interceptors := dataSourceInterceptors{}
 
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+			// All data sources support per-resource AWS Region override unless the data source
+			// already defines a top-level `region` attribute.
+			var innerSchema *dsschema.Schema
+			if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+				innerSchema = &schemaResponse.Schema
+			}
+
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := datasource.SchemaResponse{}
-				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if !v.IsComputed() {
 						errs = multierror.Append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
@@ -345,7 +387,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, v.Factory, inner, innerSchema, interceptors)
 			})
 		}
 	}
@@ -386,21 +428,30 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResourceType(typeName), meta.IgnoreTagsConfig)
 				}
 
 				return ctx
 			}
 			interceptors := resourceInterceptors{}
 
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			// All resources support per-resource AWS Region override unless the resource
+			// already defines a top-level `region` attribute.
+			// The Region interceptor must be first so that it runs before any other Before interceptors.
+			var innerSchema *rschema.Schema
+			if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+				innerSchema = &schemaResponse.Schema
+				interceptors = append(interceptors, regionResourceInterceptor{})
+			}
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = multierror.Append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -424,7 +475,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, v.Factory, inner, innerSchema, interceptors)
 			})
 		}
 	}
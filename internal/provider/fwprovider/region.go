@@ -0,0 +1,588 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionDataSourceAttribute returns the schema for the top-level `region` attribute injected into data sources.
+func regionDataSourceAttribute() dsschema.Attribute {
+	return dsschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+		Validators: []validator.String{
+			fwvalidators.RegionName(),
+		},
+	}
+}
+
+// regionResourceAttribute returns the schema for the top-level `region` attribute injected into resources.
+// Replacement on change is planned by the wrapped resource's ModifyPlan.
+func regionResourceAttribute() rschema.Attribute {
+	return rschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+		Validators: []validator.String{
+			fwvalidators.RegionName(),
+		},
+	}
+}
+
+// withoutRegion returns the specified object value, converted to the specified type, with the top-level `region` attribute removed.
+func withoutRegion(v tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if v.Type() == nil || v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	delete(m, names.AttrRegion)
+
+	return tftypes.NewValue(typ, m), nil
+}
+
+// withRegion returns the specified object value, converted to the specified type, with the top-level `region` attribute added.
+func withRegion(v tftypes.Value, typ tftypes.Type, region tftypes.Value) (tftypes.Value, error) {
+	if v.Type() == nil || v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	m[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, m), nil
+}
+
+// regionValue returns the value of the top-level `region` attribute in the specified object value.
+func regionValue(v tftypes.Value) tftypes.Value {
+	null := tftypes.NewValue(tftypes.String, nil)
+
+	if v.Type() == nil || v.IsNull() || !v.IsKnown() {
+		return null
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		return null
+	}
+
+	if v, ok := m[names.AttrRegion]; ok {
+		return v
+	}
+
+	return null
+}
+
+// regionString returns the known, non-null value of the top-level `region` attribute in the specified object value.
+func regionString(v tftypes.Value) string {
+	var region string
+
+	if v := regionValue(v); v.IsKnown() && !v.IsNull() {
+		_ = v.As(&region)
+	}
+
+	return region
+}
+
+// splitImportID splits an import ID of the form "<id>@<region>".
+// If the ID has no valid Region suffix the ID is returned unchanged.
+func splitImportID(id string) (string, string) {
+	i := strings.LastIndex(id, "@")
+
+	if i < 0 {
+		return id, ""
+	}
+
+	region := id[i+1:]
+
+	// Some resource IDs legitimately contain '@', e.g. IAM user names.
+	if _, errs := verify.ValidRegionName(region, names.AttrRegion); region == "" || len(errs) > 0 {
+		return id, ""
+	}
+
+	return id[:i], region
+}
+
+// effectiveRegion returns the AWS Region used for API operations.
+func effectiveRegion(meta *conns.AWSClient, region string) string {
+	if region != "" || meta == nil {
+		return region
+	}
+
+	return meta.Region
+}
+
+// configureRegion replaces the wrapped data source with a new instance configured with the provider Meta for the specified AWS Region.
+// Values derived from the data source's Meta(), e.g. ARNs, then reflect any per-resource Region override.
+// The wrapper is created for each request but the instance it wraps is shared by all requests, so that instance is never reconfigured.
+func (w *wrappedDataSource) configureRegion(ctx context.Context, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if w.meta == nil || region == "" || region == w.meta.Region {
+		return diags
+	}
+
+	inner, err := w.factory(ctx)
+
+	if err != nil {
+		diags.AddError("creating data source", err.Error())
+
+		return diags
+	}
+
+	var response datasource.ConfigureResponse
+	inner.Configure(ctx, datasource.ConfigureRequest{ProviderData: w.meta.RegionalClient(region)}, &response)
+	diags.Append(response.Diagnostics...)
+
+	if !diags.HasError() {
+		w.inner = inner
+	}
+
+	return diags
+}
+
+// configureRegion replaces the wrapped resource with a new instance configured with the provider Meta for the specified AWS Region.
+// Values derived from the resource's Meta(), e.g. ARNs, then reflect any per-resource Region override.
+// The wrapper is created for each request but the instance it wraps is shared by all requests, so that instance is never reconfigured.
+func (w *wrappedResource) configureRegion(ctx context.Context, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if w.meta == nil || region == "" || region == w.meta.Region {
+		return diags
+	}
+
+	inner, err := w.factory(ctx)
+
+	if err != nil {
+		diags.AddError("creating resource", err.Error())
+
+		return diags
+	}
+
+	var response resource.ConfigureResponse
+	inner.Configure(ctx, resource.ConfigureRequest{ProviderData: w.meta.RegionalClient(region)}, &response)
+	diags.Append(response.Diagnostics...)
+
+	if !diags.HasError() {
+		w.inner = inner
+	}
+
+	return diags
+}
+
+func addRegionConversionError(diags *diag.Diagnostics, err error) {
+	diags.AddError("Converting per-resource Region override data", err.Error())
+}
+
+// innerConfig returns the specified Config conforming to the wrapped data source's own schema.
+func (w *wrappedDataSource) innerConfig(ctx context.Context, v tfsdk.Config, diags *diag.Diagnostics) tfsdk.Config {
+	raw, err := withoutRegion(v.Raw, w.innerSchema.Type().TerraformType(ctx))
+
+	if err != nil {
+		addRegionConversionError(diags, err)
+	}
+
+	return tfsdk.Config{Schema: *w.innerSchema, Raw: raw}
+}
+
+// readWithRegion invokes the wrapped data source's Read handler, translating to and from its own schema.
+func (w *wrappedDataSource) readWithRegion(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	region := regionString(request.Config.Raw)
+	setContextRegion(ctx, region)
+
+	var diags diag.Diagnostics
+	innerRequest := request
+	innerRequest.Config = w.innerConfig(ctx, request.Config, &diags)
+	innerResponse := datasource.ReadResponse{
+		State: tfsdk.State{Schema: *w.innerSchema, Raw: tftypes.NewValue(w.innerSchema.Type().TerraformType(ctx), nil)},
+	}
+
+	diags.Append(w.configureRegion(ctx, region)...)
+
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+	response.Diagnostics.Append(innerResponse.Diagnostics...)
+
+	raw, err := withRegion(innerResponse.State.Raw, response.State.Schema.Type().TerraformType(ctx), tftypes.NewValue(tftypes.String, effectiveRegion(w.meta, region)))
+
+	if err != nil {
+		addRegionConversionError(&response.Diagnostics, err)
+		return
+	}
+
+	response.State.Raw = raw
+}
+
+// innerConfig returns the specified Config conforming to the wrapped resource's own schema.
+func (w *wrappedResource) innerConfig(ctx context.Context, v tfsdk.Config, diags *diag.Diagnostics) tfsdk.Config {
+	raw, err := withoutRegion(v.Raw, w.innerSchema.Type().TerraformType(ctx))
+
+	if err != nil {
+		addRegionConversionError(diags, err)
+	}
+
+	return tfsdk.Config{Schema: *w.innerSchema, Raw: raw}
+}
+
+// innerPlan returns the specified Plan conforming to the wrapped resource's own schema.
+func (w *wrappedResource) innerPlan(ctx context.Context, v tfsdk.Plan, diags *diag.Diagnostics) tfsdk.Plan {
+	raw, err := withoutRegion(v.Raw, w.innerSchema.Type().TerraformType(ctx))
+
+	if err != nil {
+		addRegionConversionError(diags, err)
+	}
+
+	return tfsdk.Plan{Schema: *w.innerSchema, Raw: raw}
+}
+
+// innerState returns the specified State conforming to the wrapped resource's own schema.
+func (w *wrappedResource) innerState(ctx context.Context, v tfsdk.State, diags *diag.Diagnostics) tfsdk.State {
+	raw, err := withoutRegion(v.Raw, w.innerSchema.Type().TerraformType(ctx))
+
+	if err != nil {
+		addRegionConversionError(diags, err)
+	}
+
+	return tfsdk.State{Schema: *w.innerSchema, Raw: raw}
+}
+
+// outerRaw returns the specified value from the wrapped resource conforming to the specified schema type
+// with the specified `region` attribute value.
+func outerRaw(v tftypes.Value, typ tftypes.Type, region tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	raw, err := withRegion(v, typ, region)
+
+	if err != nil {
+		addRegionConversionError(diags, err)
+	}
+
+	return raw
+}
+
+func (w *wrappedResource) createWithRegion(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	innerRequest := request
+	innerRequest.Config = w.innerConfig(ctx, request.Config, &diags)
+	innerRequest.Plan = w.innerPlan(ctx, request.Plan, &diags)
+	innerResponse := resource.CreateResponse{
+		Private: response.Private,
+		State:   w.innerState(ctx, response.State, &diags),
+	}
+
+	diags.Append(w.configureRegion(ctx, regionString(request.Plan.Raw))...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	w.inner.Create(ctx, innerRequest, &innerResponse)
+
+	diags.Append(innerResponse.Diagnostics...)
+	response.Private = innerResponse.Private
+	response.State.Raw = outerRaw(innerResponse.State.Raw, response.State.Schema.Type().TerraformType(ctx), regionValue(request.Plan.Raw), &diags)
+
+	return diags
+}
+
+func (w *wrappedResource) readWithRegion(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	innerRequest := request
+	innerRequest.State = w.innerState(ctx, request.State, &diags)
+	innerResponse := resource.ReadResponse{
+		Private: response.Private,
+		State:   w.innerState(ctx, response.State, &diags),
+	}
+
+	diags.Append(w.configureRegion(ctx, regionString(request.State.Raw))...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	diags.Append(innerResponse.Diagnostics...)
+	response.Private = innerResponse.Private
+	// Resources created before the `region` attribute was introduced have no value in state.
+	// Record the Region actually used.
+	region := tftypes.NewValue(tftypes.String, effectiveRegion(w.meta, regionString(request.State.Raw)))
+	response.State.Raw = outerRaw(innerResponse.State.Raw, response.State.Schema.Type().TerraformType(ctx), region, &diags)
+
+	return diags
+}
+
+func (w *wrappedResource) updateWithRegion(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	innerRequest := request
+	innerRequest.Config = w.innerConfig(ctx, request.Config, &diags)
+	innerRequest.Plan = w.innerPlan(ctx, request.Plan, &diags)
+	innerRequest.State = w.innerState(ctx, request.State, &diags)
+	innerResponse := resource.UpdateResponse{
+		Private: response.Private,
+		State:   w.innerState(ctx, response.State, &diags),
+	}
+
+	diags.Append(w.configureRegion(ctx, regionString(request.Plan.Raw))...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	w.inner.Update(ctx, innerRequest, &innerResponse)
+
+	diags.Append(innerResponse.Diagnostics...)
+	response.Private = innerResponse.Private
+	response.State.Raw = outerRaw(innerResponse.State.Raw, response.State.Schema.Type().TerraformType(ctx), regionValue(request.Plan.Raw), &diags)
+
+	return diags
+}
+
+func (w *wrappedResource) deleteWithRegion(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	innerRequest := request
+	innerRequest.State = w.innerState(ctx, request.State, &diags)
+	innerResponse := resource.DeleteResponse{
+		State: w.innerState(ctx, response.State, &diags),
+	}
+
+	diags.Append(w.configureRegion(ctx, regionString(request.State.Raw))...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	w.inner.Delete(ctx, innerRequest, &innerResponse)
+
+	diags.Append(innerResponse.Diagnostics...)
+	response.State.Raw = outerRaw(innerResponse.State.Raw, response.State.Schema.Type().TerraformType(ctx), regionValue(request.State.Raw), &diags)
+
+	return diags
+}
+
+// modifyPlanRegion plans the `region` attribute value, forcing replacement if the Region changes.
+func (w *wrappedResource) modifyPlanRegion(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() || w.meta == nil {
+		return
+	}
+
+	var configRegion fwtypes.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+
+	if response.Diagnostics.HasError() || configRegion.IsUnknown() {
+		return
+	}
+
+	planRegion := configRegion
+	if planRegion.IsNull() {
+		planRegion = fwtypes.StringValue(w.meta.Region)
+	}
+
+	if !request.State.Raw.IsNull() {
+		var stateRegion fwtypes.String
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Resources created before the `region` attribute was introduced have no value in state.
+		// Don't force their replacement; the next Read records the Region.
+		if stateRegion.IsNull() {
+			if configRegion.IsNull() {
+				planRegion = fwtypes.StringNull()
+			}
+			stateRegion = fwtypes.StringValue(w.meta.Region)
+		}
+
+		if !planRegion.IsNull() && !planRegion.Equal(stateRegion) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+		}
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), planRegion)...)
+}
+
+func (w *wrappedResource) modifyPlanWithRegion(ctx context.Context, inner resource.ResourceWithModifyPlan, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	var diags diag.Diagnostics
+	innerRequest := request
+	innerRequest.Config = w.innerConfig(ctx, request.Config, &diags)
+	innerRequest.Plan = w.innerPlan(ctx, response.Plan, &diags)
+	innerRequest.State = w.innerState(ctx, request.State, &diags)
+	innerResponse := resource.ModifyPlanResponse{
+		Plan:            w.innerPlan(ctx, response.Plan, &diags),
+		Private:         response.Private,
+		RequiresReplace: response.RequiresReplace,
+	}
+
+	diags.Append(w.configureRegion(ctx, regionString(response.Plan.Raw))...)
+
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+		inner = v
+	}
+
+	inner.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics.Append(innerResponse.Diagnostics...)
+	response.Private = innerResponse.Private
+	response.RequiresReplace = innerResponse.RequiresReplace
+	response.Plan.Raw = outerRaw(innerResponse.Plan.Raw, response.Plan.Schema.Type().TerraformType(ctx), regionValue(response.Plan.Raw), &response.Diagnostics)
+}
+
+func (w *wrappedResource) importStateWithRegion(ctx context.Context, inner resource.ResourceWithImportState, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	id, region := splitImportID(request.ID)
+
+	if region != "" {
+		setContextRegion(ctx, region)
+		request.ID = id
+		response.Diagnostics.Append(w.configureRegion(ctx, region)...)
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if v, ok := w.inner.(resource.ResourceWithImportState); ok {
+			inner = v
+		}
+	}
+
+	inner.ImportState(ctx, request, response)
+}
+
+func (w *wrappedResource) validateConfigWithRegion(ctx context.Context, inner resource.ResourceWithValidateConfig, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	innerRequest := request
+	innerRequest.Config = w.innerConfig(ctx, request.Config, &response.Diagnostics)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ValidateConfig(ctx, innerRequest, response)
+}
+
+// upgradeStateWithRegion wraps the specified state upgraders so that they produce state conforming to the wrapped resource's own schema.
+func (w *wrappedResource) upgradeStateWithRegion(upgraders map[int64]resource.StateUpgrader) map[int64]resource.StateUpgrader {
+	for k, v := range upgraders {
+		f := v.StateUpgrader
+		v.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			innerType := w.innerSchema.Type().TerraformType(ctx)
+			innerResponse := resource.UpgradeStateResponse{
+				State: tfsdk.State{Schema: *w.innerSchema, Raw: tftypes.NewValue(innerType, nil)},
+			}
+
+			f(ctx, request, &innerResponse)
+
+			response.Diagnostics.Append(innerResponse.Diagnostics...)
+
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			raw := innerResponse.State.Raw
+			if v := innerResponse.DynamicValue; v != nil {
+				var err error
+				raw, err = v.Unmarshal(innerType)
+
+				if err != nil {
+					addRegionConversionError(&response.Diagnostics, err)
+					return
+				}
+			}
+
+			response.State.Raw = outerRaw(raw, response.State.Schema.Type().TerraformType(ctx), tftypes.NewValue(tftypes.String, nil), &response.Diagnostics)
+		}
+		upgraders[k] = v
+	}
+
+	return upgraders
+}
+
+// setContextRegion overrides the AWS Region in Context.
+func setContextRegion(ctx context.Context, region string) {
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.Region = region
+	}
+}
+
+// regionResourceInterceptor implements per-resource AWS Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		setContextRegion(ctx, regionString(request.Plan.Raw))
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		setContextRegion(ctx, regionString(request.State.Raw))
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		setContextRegion(ctx, regionString(request.Plan.Raw))
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		setContextRegion(ctx, regionString(request.State.Raw))
+	}
+
+	return ctx, diags
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+type testRegionResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *testRegionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (r *testRegionResource) Schema(context.Context, resource.SchemaRequest, *resource.SchemaResponse) {
+}
+
+func (r *testRegionResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {
+}
+
+func (r *testRegionResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+}
+
+func (r *testRegionResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+func (r *testRegionResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func TestWrappedResourceConfigureRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		DNSSuffix: "amazonaws.com",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+	factory := func(context.Context) (resource.ResourceWithConfigure, error) {
+		return &testRegionResource{}, nil
+	}
+
+	// The inner resource is shared by all wrappers of the resource type.
+	shared, _ := factory(ctx)
+	shared.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &resource.ConfigureResponse{})
+
+	wrappers := make(map[string]*wrappedResource)
+	for _, region := range []string{"eu-west-1", "us-west-2", ""} { //lintignore:AWSAT003
+		w := &wrappedResource{
+			factory: factory,
+			inner:   shared,
+			meta:    meta,
+		}
+
+		if diags := w.configureRegion(ctx, region); diags.HasError() {
+			t.Fatalf("configuring Region (%s): %v", region, diags)
+		}
+
+		wrappers[region] = w
+	}
+
+	if got, want := shared.(*testRegionResource).Meta().Region, "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("shared inner resource Region = %s, want %s", got, want)
+	}
+
+	for region, want := range map[string]string{
+		"eu-west-1": "eu-west-1", //lintignore:AWSAT003
+		"us-west-2": "us-west-2", //lintignore:AWSAT003
+		"":          "us-west-2", //lintignore:AWSAT003
+	} {
+		w := wrappers[region]
+
+		if got := w.inner.(*testRegionResource).Meta().Region; got != want {
+			t.Errorf("configureRegion(%q): inner resource Region = %s, want %s", region, got, want)
+		}
+	}
+
+	if wrappers["eu-west-1"].inner == shared {
+		t.Error("configureRegion(\"eu-west-1\") reconfigured the shared inner resource")
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// tagPolicyComplianceDiags returns diagnostics for any tags that do not comply with the account's effective tag policy.
+// The diagnostics' severity is determined by the provider's tag_policy_compliance setting.
+func tagPolicyComplianceDiags(ctx context.Context, meta *conns.AWSClient, tags tftags.KeyValueTags) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if meta == nil {
+		return diags
+	}
+
+	var add func(string, string)
+	switch meta.TagPolicyCompliance {
+	case tftags.PolicyComplianceError:
+		add = diags.AddError
+	case tftags.PolicyComplianceWarning:
+		add = diags.AddWarning
+	default:
+		return diags
+	}
+
+	serviceName, resourceName := "<service>", "<thing>"
+	if inContext, ok := conns.FromContext(ctx); ok {
+		if v, err := names.HumanFriendly(inContext.ServicePackageName); err == nil {
+			serviceName = v
+		}
+		if v := inContext.ResourceName; v != "" {
+			resourceName = v
+		}
+	}
+
+	policy, err := meta.EffectiveTagPolicy(ctx)
+
+	if err != nil {
+		diags.AddWarning("Unable to check tag policy compliance", fmt.Sprintf("reading effective tag policy for %s %s: %s", serviceName, resourceName, err))
+
+		return diags
+	}
+
+	for _, v := range policy.Violations(tags) {
+		add("Tag policy violation", fmt.Sprintf("%s %s: %s", serviceName, resourceName, v.Message))
+	}
+
+	return diags
+}
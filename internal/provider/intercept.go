@@ -16,8 +16,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // schemaResourceData is an interface that implements functions from schema.ResourceData
@@ -28,6 +30,7 @@ type schemaResourceData interface {
 	GetRawPlan() cty.Value
 	GetRawState() cty.Value
 	HasChange(key string) bool
+	HasChangesExcept(keys ...string) bool
 	Id() string
 	Set(string, any) error
 }
@@ -77,6 +80,22 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+// String returns the name of a single CRUD operation.
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return ""
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -88,9 +107,19 @@ func (s interceptorItems) why(why why) interceptorItems {
 
 // interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
 func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptors interceptorItems, f F, why why) F {
-	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		var diags diag.Diagnostics
+	return func(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
 		ctx = bootstrapContext(ctx, meta)
+
+		// Each handler invocation is traced if tracing is enabled.
+		ctx, span := startHandlerSpan(ctx, why)
+		defer func() {
+			var id string
+			if d != nil {
+				id = d.Id()
+			}
+			tracing.EndHandlerSpan(span, id, sdkdiag.DiagnosticsError(diags))
+		}()
+
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -106,6 +135,9 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 			}
 		}
 
+		// A Before interceptor may have overridden the AWS Region.
+		meta = regionalMeta(ctx, meta)
+
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
 		diags = f(ctx, d, meta)
@@ -132,6 +164,17 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	}
 }
 
+// startHandlerSpan starts a tracing span for the specified CRUD handler of the data source or resource in Context.
+func startHandlerSpan(ctx context.Context, why why) (context.Context, trace.Span) {
+	var servicePackageName, typeName string
+	var isDataSource bool
+	if inContext, ok := conns.FromContext(ctx); ok {
+		servicePackageName, typeName, isDataSource = inContext.ServicePackageName, inContext.TypeName, inContext.IsDataSource
+	}
+
+	return tracing.StartHandlerSpan(ctx, servicePackageName, typeName, isDataSource, why.String())
+}
+
 // contextFunc augments Context.
 type contextFunc func(context.Context, any) context.Context
 
@@ -151,6 +194,10 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// read is the resource's Read handler, called instead of its Update handler for tags-only changes.
+	read schema.ReadContextFunc
+	// regionOverride is true if the resource's schema has the injected top-level `region` attribute.
+	regionOverride bool
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
@@ -162,7 +209,23 @@ func (r *wrappedResource) Read(f schema.ReadContextFunc) schema.ReadContextFunc
 }
 
 func (r *wrappedResource) Update(f schema.UpdateContextFunc) schema.UpdateContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Update)
+	return interceptedHandler(r.bootstrapContext, r.interceptors, r.updateOrRead(f), Update)
+}
+
+// updateOrRead returns an Update handler that calls the resource's Read handler instead of
+// the specified Update handler if a Before interceptor has already made all the planned changes.
+func (r *wrappedResource) updateOrRead(f schema.UpdateContextFunc) schema.UpdateContextFunc {
+	if r.read == nil {
+		return f
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		if v, ok := tftags.FromContext(ctx); ok && v.TagsOnlyUpdate {
+			return r.read(ctx, d, meta)
+		}
+
+		return f(ctx, d, meta)
+	}
 }
 
 func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContextFunc {
@@ -173,6 +236,18 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		if r.regionOverride {
+			var err error
+
+			ctx, err = importRegion(ctx, d)
+
+			if err != nil {
+				return nil, err
+			}
+
+			meta = regionalMeta(ctx, meta)
+		}
+
 		return f(ctx, d, meta)
 	}
 }
@@ -181,6 +256,14 @@ func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.Custo
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		if r.regionOverride {
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.Region = d.Get(names.AttrRegion).(string)
+			}
+
+			meta = regionalMeta(ctx, meta)
+		}
+
 		return f(ctx, d, meta)
 	}
 }
@@ -243,6 +326,15 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 
 			tagsInContext.TagsIn = types.Some(tags)
 
+			// Check the effective tags against the account's tag policy.
+			if why == Create || d.HasChange(names.AttrTags) || d.HasChange(names.AttrTagsAll) {
+				diags = append(diags, tagPolicyComplianceDiags(ctx, meta.(*conns.AWSClient), tags, serviceName, resourceName, d.Id())...)
+
+				if diags.HasError() {
+					return ctx, diags
+				}
+			}
+
 			if why == Create {
 				break
 			}
@@ -264,15 +356,18 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 
 							// If the service package has a generic resource update tags methods, call it.
 							var err error
+							var updated bool
 
 							if v, ok := sp.(interface {
 								UpdateTags(context.Context, any, string, any, any) error
 							}); ok {
 								err = v.UpdateTags(ctx, meta, identifier, o, n)
+								updated = true
 							} else if v, ok := sp.(interface {
 								UpdateTags(context.Context, any, string, string, any, any) error
 							}); ok && r.tags.ResourceType != "" {
 								err = v.UpdateTags(ctx, meta, identifier, r.tags.ResourceType, o, n)
+								updated = true
 							}
 
 							// ISO partitions may not support tagging, giving error.
@@ -283,8 +378,12 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 							if err != nil {
 								return ctx, sdkdiag.AppendErrorf(diags, "updating tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
 							}
+
+							// If the only change was to tags there's no need to call the resource's Update handler.
+							if updated && !r.tags.AlwaysUpdate && !d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+								tagsInContext.TagsOnlyUpdate = true
+							}
 						}
-						// TODO If the only change was to tags it would be nice to not call the resource's U handler.
 					}
 				}
 			}
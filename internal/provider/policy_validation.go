@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// policyValidationResourceTypes maps resource types to the IAM Access Analyzer resource type
+// used to run additional service-specific checks on their resource-based policies.
+var policyValidationResourceTypes = map[string]awstypes.ValidatePolicyResourceType{
+	"aws_s3_access_point":                             awstypes.ValidatePolicyResourceTypeS3AccessPoint,
+	"aws_s3_bucket":                                   awstypes.ValidatePolicyResourceTypeS3Bucket,
+	"aws_s3_bucket_policy":                            awstypes.ValidatePolicyResourceTypeS3Bucket,
+	"aws_s3control_access_point_policy":               awstypes.ValidatePolicyResourceTypeS3AccessPoint,
+	"aws_s3control_object_lambda_access_point_policy": awstypes.ValidatePolicyResourceTypeS3ObjectLambdaAccessPoint,
+}
+
+// policyAttributes returns the names of the specified resource's top-level IAM policy document attributes.
+// An attribute is considered a policy document if it suppresses diffs between equivalent policies
+// or is validated as IAM policy JSON.
+func policyAttributes(r *schema.Resource) []string {
+	var attributes []string
+
+	for k, v := range r.SchemaMap() {
+		if v.Type != schema.TypeString {
+			continue
+		}
+
+		if sameFunc(v.DiffSuppressFunc, verify.SuppressEquivalentPolicyDiffs) || sameFunc(v.ValidateFunc, verify.ValidIAMPolicyJSON) {
+			attributes = append(attributes, k)
+		}
+	}
+
+	sort.Strings(attributes)
+
+	return attributes
+}
+
+func sameFunc(f, g any) bool {
+	if f == nil || reflect.ValueOf(f).IsNil() {
+		return false
+	}
+
+	return reflect.ValueOf(f).Pointer() == reflect.ValueOf(g).Pointer()
+}
+
+// validatePolicies returns a CustomizeDiff function that validates the planned values of the specified
+// policy document attributes using IAM Access Analyzer when enabled in provider configuration.
+// ERROR and SECURITY_WARNING findings fail the plan.
+func validatePolicies(typeName string, attributes []string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		c, ok := meta.(*conns.AWSClient)
+		if !ok || !c.ValidatePolicies {
+			return nil
+		}
+
+		return validatePolicyAttributes(ctx, d, c.AccessAnalyzerClient(ctx), typeName, attributes)
+	}
+}
+
+// validatePolicyAttributes validates the planned values of the specified policy document attributes using IAM Access Analyzer.
+// Attributes that are unchanged or whose planned value is unknown are not validated.
+func validatePolicyAttributes(ctx context.Context, d *schema.ResourceDiff, conn accessanalyzer.ValidatePolicyAPIClient, typeName string, attributes []string) error {
+	var errs []error
+
+	for _, k := range attributes {
+		if !d.HasChange(k) || !d.NewValueKnown(k) {
+			continue
+		}
+
+		policy := strings.TrimSpace(d.Get(k).(string))
+		if policy == "" || policy == "{}" {
+			continue
+		}
+
+		input := policyValidationInput(typeName, k, policy)
+		findings, err := findPolicyValidationFindings(ctx, conn, input)
+
+		if err != nil {
+			tflog.Warn(ctx, "Unable to validate policy with IAM Access Analyzer", map[string]any{
+				"attribute": k,
+				"error":     err.Error(),
+			})
+			continue
+		}
+
+		for _, v := range findings {
+			switch v.FindingType {
+			case awstypes.ValidatePolicyFindingTypeError, awstypes.ValidatePolicyFindingTypeSecurityWarning:
+				errs = append(errs, policyFindingError(typeName, k, v))
+			default:
+				tflog.Warn(ctx, "IAM Access Analyzer policy finding", map[string]any{
+					"attribute":    k,
+					"finding_type": string(v.FindingType),
+					"issue_code":   aws.ToString(v.IssueCode),
+					"details":      aws.ToString(v.FindingDetails),
+				})
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// policyValidationInput returns the ValidatePolicy input for the specified resource type's policy document attribute.
+func policyValidationInput(typeName, attribute, policy string) *accessanalyzer.ValidatePolicyInput {
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policy),
+	}
+
+	switch {
+	case attribute == "assume_role_policy":
+		input.PolicyType = awstypes.PolicyTypeResourcePolicy
+		input.ValidatePolicyResourceType = awstypes.ValidatePolicyResourceTypeRoleTrust
+	case strings.HasPrefix(typeName, "aws_iam_"):
+		input.PolicyType = awstypes.PolicyTypeIdentityPolicy
+	default:
+		input.PolicyType = awstypes.PolicyTypeResourcePolicy
+		if v, ok := policyValidationResourceTypes[typeName]; ok {
+			input.ValidatePolicyResourceType = v
+		}
+	}
+
+	return input
+}
+
+func findPolicyValidationFindings(ctx context.Context, conn accessanalyzer.ValidatePolicyAPIClient, input *accessanalyzer.ValidatePolicyInput) ([]awstypes.ValidatePolicyFinding, error) {
+	var output []awstypes.ValidatePolicyFinding
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+// policyFindingError returns an error describing the specified IAM Access Analyzer finding, including its location in the policy document.
+func policyFindingError(typeName, attribute string, finding awstypes.ValidatePolicyFinding) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s %s: IAM Access Analyzer %s (%s)", typeName, attribute, finding.FindingType, aws.ToString(finding.IssueCode))
+
+	var locations []string
+	for _, v := range finding.Locations {
+		locations = append(locations, policyFindingLocation(v))
+	}
+	if len(locations) > 0 {
+		fmt.Fprintf(&sb, " at %s", strings.Join(locations, ", "))
+	}
+
+	fmt.Fprintf(&sb, ": %s", aws.ToString(finding.FindingDetails))
+
+	if v := aws.ToString(finding.LearnMoreLink); v != "" {
+		fmt.Fprintf(&sb, " (%s)", v)
+	}
+
+	return errors.New(sb.String())
+}
+
+// policyFindingLocation returns a location such as `Statement[0].Action[1] (line 5, column 7)`.
+func policyFindingLocation(location awstypes.Location) string {
+	var sb strings.Builder
+
+	for _, v := range location.Path {
+		switch v := v.(type) {
+		case *awstypes.PathElementMemberIndex:
+			fmt.Fprintf(&sb, "[%d]", v.Value)
+		case *awstypes.PathElementMemberKey:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(v.Value)
+		case *awstypes.PathElementMemberValue:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(v.Value)
+		case *awstypes.PathElementMemberSubstring:
+			start := aws.ToInt32(v.Value.Start)
+			fmt.Fprintf(&sb, "[%d:%d]", start, start+aws.ToInt32(v.Value.Length))
+		}
+	}
+
+	if v := location.Span; v != nil && v.Start != nil {
+		if sb.Len() > 0 {
+			sb.WriteString(" ")
+		}
+		// Access Analyzer lines are 1-based and columns are 0-based.
+		fmt.Fprintf(&sb, "(line %d, column %d)", aws.ToInt32(v.Start.Line), aws.ToInt32(v.Start.Column)+1)
+	}
+
+	return sb.String()
+}
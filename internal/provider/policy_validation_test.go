@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestPolicyAttributes(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"assume_role_policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIAMPolicyJSON,
+			},
+		},
+	}
+
+	if got, want := policyAttributes(r), []string{"assume_role_policy", "policy"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("policyAttributes() = %v, want %v", got, want)
+	}
+}
+
+func TestPolicyValidationInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName             string
+		typeName             string
+		attribute            string
+		expectedPolicyType   awstypes.PolicyType
+		expectedResourceType awstypes.ValidatePolicyResourceType
+	}{
+		{
+			testName:             "role trust policy",
+			typeName:             "aws_iam_role",
+			attribute:            "assume_role_policy",
+			expectedPolicyType:   awstypes.PolicyTypeResourcePolicy,
+			expectedResourceType: awstypes.ValidatePolicyResourceTypeRoleTrust,
+		},
+		{
+			testName:           "identity policy",
+			typeName:           "aws_iam_role_policy",
+			attribute:          "policy",
+			expectedPolicyType: awstypes.PolicyTypeIdentityPolicy,
+		},
+		{
+			testName:             "S3 bucket policy",
+			typeName:             "aws_s3_bucket_policy",
+			attribute:            "policy",
+			expectedPolicyType:   awstypes.PolicyTypeResourcePolicy,
+			expectedResourceType: awstypes.ValidatePolicyResourceTypeS3Bucket,
+		},
+		{
+			testName:           "KMS key policy",
+			typeName:           "aws_kms_key",
+			attribute:          "policy",
+			expectedPolicyType: awstypes.PolicyTypeResourcePolicy,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			input := policyValidationInput(testCase.typeName, testCase.attribute, "{}")
+
+			if got, want := input.PolicyType, testCase.expectedPolicyType; got != want {
+				t.Errorf("PolicyType = %s, want %s", got, want)
+			}
+			if got, want := input.ValidatePolicyResourceType, testCase.expectedResourceType; got != want {
+				t.Errorf("ValidatePolicyResourceType = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestPolicyFindingError(t *testing.T) {
+	t.Parallel()
+
+	finding := awstypes.ValidatePolicyFinding{
+		FindingDetails: aws.String("Using the iam:PassRole action with wildcards (*) in the resource can be overly permissive."),
+		FindingType:    awstypes.ValidatePolicyFindingTypeSecurityWarning,
+		IssueCode:      aws.String("PASS_ROLE_WITH_STAR_IN_RESOURCE"),
+		Locations: []awstypes.Location{
+			{
+				Path: []awstypes.PathElement{
+					&awstypes.PathElementMemberKey{Value: "Statement"},
+					&awstypes.PathElementMemberIndex{Value: 0},
+					&awstypes.PathElementMemberKey{Value: "Resource"},
+				},
+				Span: &awstypes.Span{
+					Start: &awstypes.Position{Line: aws.Int32(5), Column: aws.Int32(6), Offset: aws.Int32(70)},
+					End:   &awstypes.Position{Line: aws.Int32(5), Column: aws.Int32(21), Offset: aws.Int32(85)},
+				},
+			},
+		},
+	}
+
+	want := "aws_iam_role_policy policy: IAM Access Analyzer SECURITY_WARNING (PASS_ROLE_WITH_STAR_IN_RESOURCE) at Statement[0].Resource (line 5, column 7): Using the iam:PassRole action with wildcards (*) in the resource can be overly permissive."
+
+	if got := policyFindingError("aws_iam_role_policy", "policy", finding).Error(); got != want {
+		t.Errorf("policyFindingError() = %q, want %q", got, want)
+	}
+}
+
+// mockValidatePolicyClient returns the configured findings, or error, for every ValidatePolicy call.
+type mockValidatePolicyClient struct {
+	calls    int
+	err      error
+	findings []awstypes.ValidatePolicyFinding
+}
+
+func (c *mockValidatePolicyClient) ValidatePolicy(context.Context, *accessanalyzer.ValidatePolicyInput, ...func(*accessanalyzer.Options)) (*accessanalyzer.ValidatePolicyOutput, error) {
+	c.calls++
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &accessanalyzer.ValidatePolicyOutput{Findings: c.findings}, nil
+}
+
+func TestValidatePoliciesCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	const (
+		policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"iam:PassRole","Resource":"*"}]}`
+		// The legacy SDK representation of an unknown value.
+		unknown = "74D93920-ED26-11E3-AC10-0800200C9A66"
+	)
+
+	finding := func(findingType awstypes.ValidatePolicyFindingType) awstypes.ValidatePolicyFinding {
+		return awstypes.ValidatePolicyFinding{
+			FindingDetails: aws.String("details"),
+			FindingType:    findingType,
+			IssueCode:      aws.String("ISSUE"),
+		}
+	}
+
+	testCases := []struct {
+		testName      string
+		disabled      bool
+		policy        string
+		findings      []awstypes.ValidatePolicyFinding
+		apiErr        error
+		expectedCalls int
+		expectedError string
+	}{
+		{
+			testName: "disabled",
+			disabled: true,
+			policy:   policy,
+			findings: []awstypes.ValidatePolicyFinding{finding(awstypes.ValidatePolicyFindingTypeError)},
+		},
+		{
+			testName: "unknown value",
+			policy:   unknown,
+			findings: []awstypes.ValidatePolicyFinding{finding(awstypes.ValidatePolicyFindingTypeError)},
+		},
+		{
+			testName:      "no findings",
+			policy:        policy,
+			expectedCalls: 1,
+		},
+		{
+			testName:      "warning and suggestion findings",
+			policy:        policy,
+			findings:      []awstypes.ValidatePolicyFinding{finding(awstypes.ValidatePolicyFindingTypeWarning), finding(awstypes.ValidatePolicyFindingTypeSuggestion)},
+			expectedCalls: 1,
+		},
+		{
+			testName:      "error finding",
+			policy:        policy,
+			findings:      []awstypes.ValidatePolicyFinding{finding(awstypes.ValidatePolicyFindingTypeError)},
+			expectedCalls: 1,
+			expectedError: "aws_iam_role_policy policy: IAM Access Analyzer ERROR (ISSUE): details",
+		},
+		{
+			testName:      "security warning finding",
+			policy:        policy,
+			findings:      []awstypes.ValidatePolicyFinding{finding(awstypes.ValidatePolicyFindingTypeSecurityWarning)},
+			expectedCalls: 1,
+			expectedError: "aws_iam_role_policy policy: IAM Access Analyzer SECURITY_WARNING (ISSUE): details",
+		},
+		{
+			testName:      "API error",
+			policy:        policy,
+			apiErr:        errors.New("AccessDeniedException"),
+			expectedCalls: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			conn := &mockValidatePolicyClient{
+				err:      testCase.apiErr,
+				findings: testCase.findings,
+			}
+
+			customizeDiff := func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+				return validatePolicyAttributes(ctx, d, conn, "aws_iam_role_policy", []string{"policy"})
+			}
+			if testCase.disabled {
+				customizeDiff = validatePolicies("aws_iam_role_policy", []string{"policy"})
+			}
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"policy": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+				CustomizeDiff: customizeDiff,
+			}
+
+			config := terraform.NewResourceConfigRaw(map[string]any{
+				"policy": testCase.policy,
+			})
+
+			_, err := r.SimpleDiff(ctx, nil, config, &conns.AWSClient{ValidatePolicies: !testCase.disabled})
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Fatalf("error = %v, want %q", err, testCase.expectedError)
+			}
+
+			if got, want := conn.calls, testCase.expectedCalls; got != want {
+				t.Errorf("ValidatePolicy calls = %d, want %d", got, want)
+			}
+		})
+	}
+}
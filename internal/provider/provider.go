@@ -18,15 +18,18 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 // New returns a new, initialized Terraform Plugin SDK v2-style provider instance.
@@ -48,8 +51,34 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_rate_limits": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration blocks with client-side rate limits for AWS API requests to individual services.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0.01),
+							Description:  "The maximum sustained rate of requests, including retries, to the service's API.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service, e.g. route53, whose API requests are rate limited. Service names are as in the endpoints block.",
+						},
+					},
+				},
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"batch_reads": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Coalesce concurrent reads of individual resources into batched API calls where supported.\n" +
+					"Reduces the number of API calls made when refreshing large numbers of resources.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -64,6 +93,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. aws_autoscaling_group, to which the default tags are not applied",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -215,6 +250,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy_compliance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(tftags.PolicyComplianceValues(), false),
+				Description: "The severity with which to enforce the account's effective AWS Organizations tag policy " +
+					"on resource tags before Create and Update. Valid values are `error`, `warning` and `disabled` (the default). " +
+					"Can also be configured using the `TF_AWS_TAG_POLICY_COMPLIANCE` environment variable.",
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -231,6 +274,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"validate_policies": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Validate IAM policy documents with IAM Access Analyzer during plan. " +
+					"ERROR and SECURITY_WARNING findings fail the plan.",
+			},
 		},
 
 		// Data sources and resources implemented using Terraform Plugin SDK
@@ -270,7 +319,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 				}
@@ -279,6 +328,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// All data sources support per-resource AWS Region override unless the data source
+			// already defines a top-level `region` attribute.
+			if injectRegionAttribute(r, regionDataSourceSchema) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -346,15 +405,53 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResourceType(typeName), v.IgnoreTagsConfig)
 				}
 
 				return ctx
 			}
 			interceptors := interceptorItems{}
 
+			// All resources support per-resource AWS Region override unless the resource
+			// already defines a top-level `region` attribute.
+			// The Region interceptor must be first so that it runs before any other Before interceptors.
+			regionOverride := injectRegionAttribute(r, regionResourceSchema)
+			if regionOverride {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(setRegionInPlan, v)
+				} else {
+					r.CustomizeDiff = setRegionInPlan
+				}
+			}
+
+			// Policy document attributes are optionally validated with IAM Access Analyzer during plan.
+			if v := policyAttributes(r); len(v) > 0 {
+				f := validatePolicies(typeName, v)
+
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(v, f)
+				} else {
+					r.CustomizeDiff = f
+				}
+			}
+
+			// Write-only attributes are sent to the AWS API but never stored in state.
+			if v := injectWriteOnlyAttributes(r); len(v) > 0 {
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: writeOnlyInterceptor{attributes: v},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -393,6 +490,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
+				read:             r.ReadWithoutTimeout,
+				regionOverride:   regionOverride,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -457,6 +556,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		BatchReads:                     d.Get("batch_reads").(bool),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -472,10 +572,12 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
+		TagPolicyCompliance:            d.Get("tag_policy_compliance").(string),
 		TerraformVersion:               terraformVersion,
 		Token:                          d.Get("token").(string),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+		ValidatePolicies:               d.Get("validate_policies").(bool),
 	}
 
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
@@ -486,6 +588,13 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.RetryMode = mode
 	}
 
+	if config.TagPolicyCompliance == "" {
+		config.TagPolicyCompliance = os.Getenv(envvar.TagPolicyCompliance)
+	}
+	if _, errs := validation.StringInSlice(tftags.PolicyComplianceValues(), false)(config.TagPolicyCompliance, "tag_policy_compliance"); config.TagPolicyCompliance != "" && len(errs) > 0 {
+		return nil, sdkdiag.AppendFromErr(diags, errs[0])
+	}
+
 	if v, ok := d.Get("s3_us_east_1_regional_endpoint").(string); ok && v != "" {
 		endpoint, err := endpoints.GetS3UsEast1RegionalEndpoint(v)
 		if err != nil {
@@ -498,14 +607,34 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("api_rate_limits"); ok && v.(*schema.Set).Len() > 0 {
+		apiRateLimits, err := expandAPIRateLimits(ctx, v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		config.APIRateLimits = apiRateLimits
+	}
+
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		assumeRoles, err := expandAssumeRoles(ctx, v.([]interface{}))
+
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		config.AssumeRole = assumeRoles
+
+		for i, assumeRole := range config.AssumeRole {
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -574,11 +703,32 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	return meta, diags
 }
 
+// injectRegionAttribute adds a top-level `region` attribute to the specified resource's schema.
+// Returns false if the schema already has a `region` attribute.
+func injectRegionAttribute(r *schema.Resource, f func() *schema.Schema) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	if v := r.SchemaFunc; v != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			schema := v()
+			schema[names.AttrRegion] = f()
+
+			return schema
+		}
+	} else {
+		r.Schema[names.AttrRegion] = f()
+	}
+
+	return true
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume, in order. Each role is assumed using the credentials obtained by assuming the previous one.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -723,6 +873,30 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+// expandAssumeRoles expands the `assume_role` blocks, in order.
+// When more than one IAM Role is to be assumed each block must specify a `role_arn`.
+func expandAssumeRoles(ctx context.Context, tfList []interface{}) ([]*awsbase.AssumeRole, error) {
+	var assumeRoles []*awsbase.AssumeRole
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			tfMap = make(map[string]interface{})
+		}
+
+		assumeRole := expandAssumeRole(ctx, tfMap)
+
+		if assumeRole.RoleARN == "" && len(tfList) > 1 {
+			return nil, fmt.Errorf("assume_role[%d]: role_arn must be set when more than one assume_role block is configured", i)
+		}
+
+		assumeRoles = append(assumeRoles, assumeRole)
+	}
+
+	return assumeRoles, nil
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -820,6 +994,10 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
+	if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
 	return defaultConfig
 }
 
@@ -841,6 +1019,42 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandAPIRateLimits(_ context.Context, tfList []interface{}) (map[string]float64, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	apiRateLimits := make(map[string]float64)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+		pkg := service
+		if !slices.Contains(names.ProviderPackages(), pkg) {
+			v, err := names.ProviderPackageForAlias(service)
+
+			if err != nil {
+				return nil, fmt.Errorf("api_rate_limits: unknown service (%s)", service)
+			}
+
+			pkg = v
+		}
+
+		if _, ok := apiRateLimits[pkg]; ok {
+			return nil, fmt.Errorf("api_rate_limits: duplicate service (%s)", service)
+		}
+
+		apiRateLimits[pkg] = tfMap["requests_per_second"].(float64)
+	}
+
+	return apiRateLimits, nil
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
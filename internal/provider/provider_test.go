@@ -205,3 +205,70 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandAssumeRoles(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         []interface{}
+		expectedARNs  []string
+		expectedError bool
+	}{
+		"single": {
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/one"},
+			},
+			expectedARNs: []string{"arn:aws:iam::123456789012:role/one"},
+		},
+		"chained": {
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/one"},
+				map[string]interface{}{"role_arn": "arn:aws:iam::210987654321:role/two", "session_name": "two"},
+			},
+			expectedARNs: []string{"arn:aws:iam::123456789012:role/one", "arn:aws:iam::210987654321:role/two"},
+		},
+		"single empty": {
+			input:        []interface{}{nil},
+			expectedARNs: []string{""},
+		},
+		"chained missing role_arn": {
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/one"},
+				nil,
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandAssumeRoles(context.Background(), testCase.input)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != len(testCase.expectedARNs) {
+				t.Fatalf("expected %d assume roles, got %d", len(testCase.expectedARNs), len(got))
+			}
+
+			for i, v := range got {
+				if v.RoleARN != testCase.expectedARNs[i] {
+					t.Errorf("assume_role[%d]: expected role_arn %q, got %q", i, testCase.expectedARNs[i], v.RoleARN)
+				}
+			}
+		})
+	}
+}
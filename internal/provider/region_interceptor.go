@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionDataSourceSchema returns the schema for the top-level `region` attribute injected into data sources.
+func regionDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+		ValidateFunc: verify.ValidRegionName,
+	}
+}
+
+// regionResourceSchema returns the schema for the top-level `region` attribute injected into resources.
+func regionResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		Description:  "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+		ValidateFunc: verify.ValidRegionName,
+	}
+}
+
+// regionalMeta returns the provider Meta to use given any per-resource AWS Region override in Context.
+func regionalMeta(ctx context.Context, meta any) any {
+	if v, ok := meta.(*conns.AWSClient); ok {
+		if inContext, ok := conns.FromContext(ctx); ok {
+			return v.RegionalClient(inContext.Region)
+		}
+	}
+
+	return meta
+}
+
+// regionInterceptor implements per-resource AWS Region override for data sources and resources.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Any configured (or planned) Region is used to select the AWS API clients.
+		inContext.Region = d.Get(names.AttrRegion).(string)
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update:
+			// Record the Region actually used. meta is the regional client at this point.
+			if v, ok := meta.(*conns.AWSClient); ok {
+				if err := d.Set(names.AttrRegion, v.Region); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+				}
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// setRegionInPlan is a CustomizeDiff function that plans the provider's configured Region
+// for a resource whose `region` attribute is not configured.
+func setRegionInPlan(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.GetRawConfig().GetAttr(names.AttrRegion).IsNull() {
+		return nil
+	}
+
+	providerRegion := meta.(*conns.AWSClient).Region
+
+	if d.Id() == "" {
+		return d.SetNew(names.AttrRegion, providerRegion)
+	}
+
+	// Resources created before the `region` attribute was introduced have no value in state.
+	// Don't force their replacement; the next Read records the Region.
+	if o, _ := d.GetChange(names.AttrRegion); o.(string) != "" && o.(string) != providerRegion {
+		return d.SetNew(names.AttrRegion, providerRegion)
+	}
+
+	return nil
+}
+
+// importRegion handles an import ID of the form "<id>@<region>",
+// setting the resource's ID and `region` attribute and overriding the AWS Region in Context.
+func importRegion(ctx context.Context, d *schema.ResourceData) (context.Context, error) {
+	id := d.Id()
+	i := strings.LastIndex(id, "@")
+
+	if i < 0 {
+		return ctx, nil
+	}
+
+	region := id[i+1:]
+
+	// Some resource IDs legitimately contain '@', e.g. IAM user names.
+	if _, errs := verify.ValidRegionName(region, names.AttrRegion); region == "" || len(errs) > 0 {
+		return ctx, nil
+	}
+
+	d.SetId(id[:i])
+	if err := d.Set(names.AttrRegion, region); err != nil {
+		return ctx, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+	}
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.Region = region
+	}
+
+	return ctx, nil
+}
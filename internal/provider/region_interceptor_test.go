@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRegionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+
+	if !injectRegionAttribute(r, regionResourceSchema) {
+		t.Fatal("region attribute not injected")
+	}
+	if injectRegionAttribute(r, regionResourceSchema) {
+		t.Fatal("region attribute injected twice")
+	}
+
+	meta := &conns.AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+	ctx := conns.NewResourceContext(context.Background(), "Test", "Test", "aws_test")
+	d := r.TestResourceData()
+	d.SetId("id")
+
+	if err := d.Set(names.AttrRegion, "eu-west-1"); err != nil { //lintignore:AWSAT003
+		t.Fatal(err)
+	}
+
+	var diags diag.Diagnostics
+	ctx, diags = regionInterceptor{}.run(ctx, d, meta, Before, Read, diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := regionalMeta(ctx, meta).(*conns.AWSClient).Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region = %s, want %s", got, want)
+	}
+}
+
+func TestImportRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		ID             string
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{
+			Name:       "no region",
+			ID:         "vpc-12345678",
+			ExpectedID: "vpc-12345678",
+		},
+		{
+			Name:           "region",
+			ID:             "vpc-12345678@us-west-2", //lintignore:AWSAT003
+			ExpectedID:     "vpc-12345678",
+			ExpectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:       "not a region",
+			ID:         "user@example.com",
+			ExpectedID: "user@example.com",
+		},
+		{
+			Name:       "empty region",
+			ID:         "vpc-12345678@",
+			ExpectedID: "vpc-12345678@",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{},
+			}
+			injectRegionAttribute(r, regionResourceSchema)
+			d := r.TestResourceData()
+			d.SetId(testCase.ID)
+			ctx := conns.NewResourceContext(context.Background(), "Test", "Test", "aws_test")
+
+			ctx, err := importRegion(ctx, d)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := d.Id(), testCase.ExpectedID; got != want {
+				t.Errorf("ID = %s, want %s", got, want)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.ExpectedRegion; got != want {
+				t.Errorf("region = %s, want %s", got, want)
+			}
+			if inContext, _ := conns.FromContext(ctx); inContext.Region != testCase.ExpectedRegion {
+				t.Errorf("Context region = %s, want %s", inContext.Region, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
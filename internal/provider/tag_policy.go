@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// tagPolicyComplianceDiags returns diagnostics for any tags that do not comply with the account's effective tag policy.
+// The diagnostics' severity is determined by the provider's tag_policy_compliance setting.
+func tagPolicyComplianceDiags(ctx context.Context, c *conns.AWSClient, tags tftags.KeyValueTags, serviceName, resourceName, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var severity diag.Severity
+	switch c.TagPolicyCompliance {
+	case tftags.PolicyComplianceError:
+		severity = diag.Error
+	case tftags.PolicyComplianceWarning:
+		severity = diag.Warning
+	default:
+		return diags
+	}
+
+	resource := fmt.Sprintf("%s %s", serviceName, resourceName)
+	if id != "" {
+		resource = fmt.Sprintf("%s (%s)", resource, id)
+	}
+
+	policy, err := c.EffectiveTagPolicy(ctx)
+
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unable to check tag policy compliance",
+			Detail:   fmt.Sprintf("reading effective tag policy for %s: %s", resource, err),
+		})
+	}
+
+	for _, v := range policy.Violations(tags) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  "Tag policy violation",
+			Detail:   fmt.Sprintf("%s: %s", resource, v.Message),
+		})
+	}
+
+	return diags
+}
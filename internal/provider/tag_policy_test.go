@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestTagsResourceInterceptorTagPolicyCompliance(t *testing.T) {
+	t.Parallel()
+
+	// The configured tag "tag1" does not match the policy's capitalization.
+	policy, err := tftags.NewPolicy(`{"tags": {"tag1": {"tag_key": {"@@assign": "Tag1"}}}}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		compliance   string
+		why          why
+		wantSeverity diag.Severity
+		wantDiags    int
+	}{
+		"disabled": {
+			compliance: tftags.PolicyComplianceDisabled,
+			why:        Create,
+		},
+		"warning": {
+			compliance:   tftags.PolicyComplianceWarning,
+			why:          Create,
+			wantSeverity: diag.Warning,
+			wantDiags:    1,
+		},
+		"error": {
+			compliance:   tftags.PolicyComplianceError,
+			why:          Create,
+			wantSeverity: diag.Error,
+			wantDiags:    1,
+		},
+		"update without tag changes": {
+			compliance: tftags.PolicyComplianceError,
+			why:        Update,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tags := tagsResourceInterceptor{
+				tags: &types.ServicePackageResourceTags{
+					IdentifierAttribute: "id",
+				},
+				updateFunc: tagsUpdateFunc,
+				readFunc:   tagsReadFunc,
+			}
+
+			conn := &conns.AWSClient{
+				ServicePackages: map[string]conns.ServicePackage{
+					"Test": &mockService{},
+				},
+				TagPolicyCompliance: testCase.compliance,
+			}
+			conn.SetEffectiveTagPolicy(policy)
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "Test", "aws_test")
+			ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig, conn.IgnoreTagsConfig)
+
+			_, diags := tags.run(ctx, &tagsResourceData{}, conn, Before, testCase.why, nil)
+
+			if got, want := len(diags), testCase.wantDiags; got != want {
+				t.Fatalf("length of diags = %d, want %d: %v", got, want, diags)
+			}
+			for _, v := range diags {
+				if got, want := v.Severity, testCase.wantSeverity; got != want {
+					t.Errorf("severity = %v, want %v", got, want)
+				}
+				if got, want := v.Summary, "Tag policy violation"; got != want {
+					t.Errorf("summary = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
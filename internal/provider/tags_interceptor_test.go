@@ -10,9 +10,11 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"golang.org/x/exp/slices"
 )
 
 type mockService struct{}
@@ -86,7 +88,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+		ctx = conns.NewResourceContext(ctx, "Test", "Test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 		}
@@ -106,6 +108,94 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 }
 
+func TestTagsResourceInterceptorExcludeResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeName string
+		want     map[string]string
+	}{
+		"included": {
+			typeName: "aws_test",
+			want: map[string]string{
+				"tag1":        "value1",
+				"defaultkey1": "defaultvalue1",
+			},
+		},
+		"excluded": {
+			typeName: "aws_test_excluded",
+			want: map[string]string{
+				"tag1": "value1",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tags := tagsResourceInterceptor{
+				tags: &types.ServicePackageResourceTags{
+					IdentifierAttribute: "id",
+				},
+				updateFunc: tagsUpdateFunc,
+				readFunc:   tagsReadFunc,
+			}
+
+			conn := &conns.AWSClient{
+				ServicePackages: map[string]conns.ServicePackage{
+					"Test": &mockService{},
+				},
+				DefaultTagsConfig: expandDefaultTags(context.Background(), map[string]interface{}{
+					"tags": map[string]interface{}{
+						"defaultkey1": "defaultvalue1",
+					},
+					"exclude_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_test_excluded"}),
+				}),
+			}
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "Test", testCase.typeName)
+			ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig.ForResourceType(testCase.typeName), conn.IgnoreTagsConfig)
+
+			ctx, diags := tags.run(ctx, &tagsResourceData{}, conn, Before, Create, nil)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			inContext, ok := tftags.FromContext(ctx)
+			if !ok {
+				t.Fatal("no tagging information in Context")
+			}
+
+			got := inContext.TagsIn.UnwrapOrDefault().Map()
+			if len(got) != len(testCase.want) {
+				t.Fatalf("TagsIn = %v, want %v", got, testCase.want)
+			}
+			for k, v := range testCase.want {
+				if got[k] != v {
+					t.Errorf("TagsIn[%q] = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+// tagsResourceData is a resourceData with configured tags.
+type tagsResourceData struct {
+	resourceData
+}
+
+func (d *tagsResourceData) Get(key string) any {
+	if key == "tags" {
+		return map[string]interface{}{
+			"tag1": "value1",
+		}
+	}
+
+	return nil
+}
+
 type resourceData struct{}
 
 func (d *resourceData) GetRawConfig() cty.Value {
@@ -147,3 +237,173 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 func (d *resourceData) HasChange(key string) bool {
 	return false
 }
+
+func (d *resourceData) HasChangesExcept(keys ...string) bool {
+	return false
+}
+
+type mockUpdateTagsService struct {
+	mockService
+	updated bool
+}
+
+func (t *mockUpdateTagsService) UpdateTags(context.Context, any, string, any, any) error {
+	t.updated = true
+
+	return nil
+}
+
+// updateResourceData is a resourceData with changes to the specified attributes.
+type updateResourceData struct {
+	tagsResourceData
+	changes []string
+}
+
+func (d *updateResourceData) GetRawPlan() cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"tags_all": cty.MapVal(map[string]cty.Value{
+			"tag1": cty.StringVal("value1"),
+		}),
+	})
+}
+
+func (d *updateResourceData) GetChange(key string) (interface{}, interface{}) {
+	return map[string]interface{}{}, map[string]interface{}{
+		"tag1": "value1",
+	}
+}
+
+func (d *updateResourceData) HasChange(key string) bool {
+	for _, v := range d.changes {
+		if v == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *updateResourceData) HasChangesExcept(keys ...string) bool {
+	for _, v := range d.changes {
+		if !slices.Contains(keys, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestTagsResourceInterceptorTagsOnlyUpdate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		changes      []string
+		alwaysUpdate bool
+		want         bool
+	}{
+		"tags only": {
+			changes: []string{"tags", "tags_all"},
+			want:    true,
+		},
+		"tags and other attributes": {
+			changes: []string{"description", "tags", "tags_all"},
+			want:    false,
+		},
+		"always update": {
+			changes:      []string{"tags", "tags_all"},
+			alwaysUpdate: true,
+			want:         false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tags := tagsResourceInterceptor{
+				tags: &types.ServicePackageResourceTags{
+					IdentifierAttribute: "id",
+					AlwaysUpdate:        testCase.alwaysUpdate,
+				},
+				updateFunc: tagsUpdateFunc,
+				readFunc:   tagsReadFunc,
+			}
+
+			sp := &mockUpdateTagsService{}
+			conn := &conns.AWSClient{
+				ServicePackages: map[string]conns.ServicePackage{
+					"Test": sp,
+				},
+			}
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "Test", "aws_test")
+			ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig, conn.IgnoreTagsConfig)
+
+			ctx, diags := tags.run(ctx, &updateResourceData{changes: testCase.changes}, conn, Before, Update, nil)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !sp.updated {
+				t.Error("UpdateTags not called")
+			}
+
+			inContext, ok := tftags.FromContext(ctx)
+			if !ok {
+				t.Fatal("no tagging information in Context")
+			}
+
+			if got, want := inContext.TagsOnlyUpdate, testCase.want; got != want {
+				t.Errorf("TagsOnlyUpdate = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestWrappedResourceUpdateOrRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tagsOnlyUpdate bool
+		want           string
+	}{
+		"update": {
+			want: "update",
+		},
+		"tags only update": {
+			tagsOnlyUpdate: true,
+			want:           "read",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			rs := &wrappedResource{
+				read: func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+					got = "read"
+					return nil
+				},
+			}
+			update := func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+				got = "update"
+				return nil
+			}
+
+			ctx := tftags.NewContext(context.Background(), nil, nil)
+			if inContext, ok := tftags.FromContext(ctx); ok {
+				inContext.TagsOnlyUpdate = testCase.tagsOnlyUpdate
+			}
+
+			rs.updateOrRead(update)(ctx, nil, nil)
+
+			if got != testCase.want {
+				t.Errorf("handler called = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+)
+
+// injectWriteOnlyAttributes marks the specified resource's write-only attributes as Sensitive and
+// suppresses any diff on them, so that their values are never planned or stored.
+// Returns the names of the resource's write-only attributes.
+func injectWriteOnlyAttributes(r *schema.Resource) []string {
+	attributes := sdkv2.WriteOnlyAttributes(r.SchemaMap())
+
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	f := func(s map[string]*schema.Schema) {
+		for _, k := range attributes {
+			s[k].Sensitive = true
+			s[k].DiffSuppressFunc = sdkv2.SuppressWriteOnlyDiff
+		}
+	}
+
+	if v := r.SchemaFunc; v != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			schema := v()
+			f(schema)
+
+			return schema
+		}
+	} else {
+		f(r.Schema)
+	}
+
+	return attributes
+}
+
+// writeOnlyInterceptor ensures that write-only attribute values are never persisted in state.
+type writeOnlyInterceptor struct {
+	attributes []string
+}
+
+func (r writeOnlyInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		switch why {
+		case Create, Read, Update:
+			for _, k := range r.attributes {
+				if err := d.Set(k, nil); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "clearing write-only attribute %s: %s", k, err)
+				}
+			}
+		}
+	}
+
+	return ctx, diags
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWriteOnlyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"password_wo": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+			},
+		},
+	}
+
+	attributes := injectWriteOnlyAttributes(r)
+
+	if got, want := len(attributes), 1; got != want {
+		t.Fatalf("len(attributes) = %d, want %d", got, want)
+	}
+	if !r.Schema["password_wo"].Sensitive {
+		t.Error("write-only attribute not Sensitive")
+	}
+	if r.Schema["password_wo"].DiffSuppressFunc == nil {
+		t.Error("write-only attribute has no DiffSuppressFunc")
+	}
+	if r.Schema["password_wo_version"].Sensitive {
+		t.Error("write-only version attribute Sensitive")
+	}
+
+	ctx := context.Background()
+	d := r.TestResourceData()
+	d.SetId("id")
+
+	if err := d.Set("password_wo", "s3cr3t"); err != nil {
+		t.Fatal(err)
+	}
+
+	var diags diag.Diagnostics
+	_, diags = writeOnlyInterceptor{attributes: attributes}.run(ctx, d, nil, After, Create, diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("password_wo").(string); got != "" {
+		t.Errorf("password_wo = %q, want empty", got)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// WriteOnlyAttributeSuffix is the suffix of the name of a top-level write-only attribute.
+	// A write-only attribute's value is sent to the AWS API during Create and Update but is never stored in state.
+	// The value is emulated as a Sensitive attribute, so it is still recorded in saved plan files.
+	// Changes to the value are signalled by incrementing a companion `<name>_version` attribute.
+	WriteOnlyAttributeSuffix = "_wo"
+	// WriteOnlyVersionAttributeSuffix is the suffix of the name of a write-only attribute's version trigger.
+	WriteOnlyVersionAttributeSuffix = WriteOnlyAttributeSuffix + "_version"
+)
+
+// IsWriteOnlyAttribute returns whether the specified top-level attribute name is that of a write-only attribute.
+func IsWriteOnlyAttribute(name string) bool {
+	return strings.HasSuffix(name, WriteOnlyAttributeSuffix)
+}
+
+// WriteOnlyAttributes returns the names of the write-only attributes in the specified resource schema.
+// An attribute is only write-only if the schema also has its `<name>_version` trigger attribute,
+// as changes to the attribute's value are otherwise never planned.
+func WriteOnlyAttributes(s map[string]*schema.Schema) []string {
+	var names []string
+
+	for k, v := range s {
+		if !IsWriteOnlyAttribute(k) || v.Type != schema.TypeString || !v.Optional || v.Computed {
+			continue
+		}
+
+		if version, ok := s[k+"_version"]; !ok || version.Type != schema.TypeInt {
+			continue
+		}
+
+		names = append(names, k)
+	}
+
+	return names
+}
+
+// SuppressWriteOnlyDiff is a DiffSuppressFunc for write-only attributes.
+// Write-only attribute values are never compared with state, so any ForceNew on the attribute has no effect;
+// the companion version attribute triggers the update or replacement instead.
+func SuppressWriteOnlyDiff(k, old, new string, d *schema.ResourceData) bool {
+	return true
+}
+
+type rawConfigGetter interface {
+	GetRawConfig() cty.Value
+}
+
+// GetWriteOnlyString returns the configured value of the specified top-level write-only string attribute.
+// Write-only attribute values must be read from configuration as they are never present in plan or state.
+func GetWriteOnlyString(d rawConfigGetter, key string) (string, bool) {
+	config := d.GetRawConfig()
+
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(key) {
+		return "", false
+	}
+
+	v := config.GetAttr(key)
+
+	if v.IsNull() || !v.IsKnown() || v.Type() != cty.String {
+		return "", false
+	}
+
+	return v.AsString(), true
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type mockRawConfigGetter struct {
+	config cty.Value
+}
+
+func (m mockRawConfigGetter) GetRawConfig() cty.Value {
+	return m.config
+}
+
+func TestGetWriteOnlyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config        cty.Value
+		key           string
+		expectedValue string
+		expectedOK    bool
+	}{
+		"no config": {
+			config: cty.NilVal,
+			key:    "password_wo",
+		},
+		"null config": {
+			config: cty.NullVal(cty.Object(map[string]cty.Type{"password_wo": cty.String})),
+			key:    "password_wo",
+		},
+		"not in schema": {
+			config: cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("test")}),
+			key:    "password_wo",
+		},
+		"null value": {
+			config: cty.ObjectVal(map[string]cty.Value{"password_wo": cty.NullVal(cty.String)}),
+			key:    "password_wo",
+		},
+		"unknown value": {
+			config: cty.ObjectVal(map[string]cty.Value{"password_wo": cty.UnknownVal(cty.String)}),
+			key:    "password_wo",
+		},
+		"configured value": {
+			config:        cty.ObjectVal(map[string]cty.Value{"password_wo": cty.StringVal("s3cr3t")}),
+			key:           "password_wo",
+			expectedValue: "s3cr3t",
+			expectedOK:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value, ok := GetWriteOnlyString(mockRawConfigGetter{config: testCase.config}, testCase.key)
+
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := value, testCase.expectedValue; got != want {
+				t.Errorf("value = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWriteOnlyAttributes(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"password": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"password_wo": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"password_wo_version": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"computed_wo": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"computed_wo_version": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"unversioned_wo": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+	}
+
+	if diff := cmp.Diff(WriteOnlyAttributes(s), []string{"password_wo"}); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
@@ -18,16 +18,23 @@ func TestAccAccessAnalyzer_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Analyzer": {
-			"basic":             testAccAnalyzer_basic,
-			"disappears":        testAccAnalyzer_disappears,
-			"tags":              testAccAnalyzer_tags,
-			"Type_Organization": testAccAnalyzer_Type_Organization,
+			"basic":                    testAccAnalyzer_basic,
+			"disappears":               testAccAnalyzer_disappears,
+			"tags":                     testAccAnalyzer_tags,
+			"Type_AccountUnusedAccess": testAccAnalyzer_Type_AccountUnusedAccess,
+			"Type_Organization":        testAccAnalyzer_Type_Organization,
+		},
+		"AnalyzerDataSource": {
+			"basic": testAccAnalyzerDataSource_basic,
 		},
 		"ArchiveRule": {
 			"basic":          testAccAnalyzerArchiveRule_basic,
 			"disappears":     testAccAnalyzerArchiveRule_disappears,
 			"update_filters": testAccAnalyzerArchiveRule_updateFilters,
 		},
+		"FindingsDataSource": {
+			"basic": testAccFindingsDataSource_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -62,6 +62,33 @@ func resourceAnalyzer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unused_access": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"unused_access_age": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 180),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"type": {
@@ -89,6 +116,10 @@ func resourceAnalyzerCreate(ctx context.Context, d *schema.ResourceData, meta in
 		Type:         types.Type(d.Get("type").(string)),
 	}
 
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 {
+		input.Configuration = expandAnalyzerConfiguration(v.([]interface{})[0])
+	}
+
 	// Handle Organizations eventual consistency.
 	_, err := tfresource.RetryWhen(ctx, organizationCreationTimeout,
 		func() (interface{}, error) {
@@ -130,6 +161,9 @@ func resourceAnalyzerRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set("analyzer_name", analyzer.Name)
 	d.Set("arn", analyzer.Arn)
+	if err := d.Set("configuration", flattenAnalyzerConfiguration(analyzer.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
 	d.Set("type", analyzer.Type)
 
 	setTagsOut(ctx, analyzer.Tags)
@@ -190,3 +224,35 @@ func findAnalyzerByName(ctx context.Context, conn *accessanalyzer.Client, name s
 
 	return output.Analyzer, nil
 }
+
+func expandAnalyzerConfiguration(tfMap interface{}) types.AnalyzerConfiguration {
+	// An empty configuration block is a nil list element.
+	m, _ := tfMap.(map[string]interface{})
+
+	if v, ok := m["unused_access"].([]interface{}); ok && len(v) > 0 {
+		apiObject := &types.AnalyzerConfigurationMemberUnusedAccess{}
+
+		if m, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := m["unused_access_age"].(int); ok && v != 0 {
+				apiObject.Value.UnusedAccessAge = aws.Int32(int32(v))
+			}
+		}
+
+		return apiObject
+	}
+
+	return nil
+}
+
+func flattenAnalyzerConfiguration(apiObject types.AnalyzerConfiguration) []interface{} {
+	switch v := apiObject.(type) {
+	case *types.AnalyzerConfigurationMemberUnusedAccess:
+		return []interface{}{map[string]interface{}{
+			"unused_access": []interface{}{map[string]interface{}{
+				"unused_access_age": aws.ToInt32(v.Value.UnusedAccessAge),
+			}},
+		}}
+	}
+
+	return nil
+}
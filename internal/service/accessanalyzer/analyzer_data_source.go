@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_accessanalyzer_analyzer")
+func dataSourceAnalyzer() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAnalyzerRead,
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unused_access": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"unused_access_age": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_resource_analyzed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_resource_analyzed_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAnalyzerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("analyzer_name").(string)
+	analyzer, err := findAnalyzerByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Access Analyzer Analyzer (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(analyzer.Name))
+	d.Set("analyzer_name", analyzer.Name)
+	d.Set("arn", analyzer.Arn)
+	if err := d.Set("configuration", flattenAnalyzerConfiguration(analyzer.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	if v := analyzer.CreatedAt; v != nil {
+		d.Set("created_at", aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set("last_resource_analyzed", analyzer.LastResourceAnalyzed)
+	if v := analyzer.LastResourceAnalyzedAt; v != nil {
+		d.Set("last_resource_analyzed_at", aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set("status", analyzer.Status)
+	d.Set("type", analyzer.Type)
+
+	if err := d.Set(names.AttrTags, KeyValueTags(ctx, analyzer.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
@@ -8,7 +8,9 @@ var (
 	ArchiveRuleParseResourceID  = archiveRuleParseResourceID
 	FindAnalyzerByName          = findAnalyzerByName
 	FindArchiveRuleByTwoPartKey = findArchiveRuleByTwoPartKey
+	FindPolicyGenerationByID    = findPolicyGenerationByID
 
-	ResourceAnalyzer    = resourceAnalyzer
-	ResourceArchiveRule = resourceArchiveRule
+	ResourceAnalyzer         = resourceAnalyzer
+	ResourceArchiveRule      = resourceArchiveRule
+	ResourcePolicyGeneration = resourcePolicyGeneration
 )
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_accessanalyzer_findings")
+func dataSourceFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"finding_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.FindingType](),
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analyzed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_owner_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ResourceType](),
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.FindingStatus](),
+			},
+		},
+	}
+}
+
+func dataSourceFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	analyzerARN := d.Get("analyzer_arn").(string)
+	input := &accessanalyzer.ListFindingsV2Input{
+		AnalyzerArn: aws.String(analyzerARN),
+		Filter:      make(map[string]types.Criterion),
+	}
+
+	if v, ok := d.GetOk("finding_type"); ok {
+		input.Filter["findingType"] = types.Criterion{Eq: []string{v.(string)}}
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.Filter["resourceType"] = types.Criterion{Eq: []string{v.(string)}}
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Filter["status"] = types.Criterion{Eq: []string{v.(string)}}
+	}
+
+	findings, err := findFindings(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Access Analyzer Findings (%s): %s", analyzerARN, err)
+	}
+
+	var ids []string
+	for _, v := range findings {
+		ids = append(ids, aws.ToString(v.Id))
+	}
+
+	d.SetId(analyzerARN)
+	if err := d.Set("findings", flattenFindingSummaries(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+	d.Set("ids", ids)
+
+	return diags
+}
+
+func findFindings(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ListFindingsV2Input) ([]types.FindingSummaryV2, error) {
+	var output []types.FindingSummaryV2
+
+	pages := accessanalyzer.NewListFindingsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+func flattenFindingSummaries(apiObjects []types.FindingSummaryV2) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"error":                  aws.ToString(apiObject.Error),
+			"finding_type":           string(apiObject.FindingType),
+			"id":                     aws.ToString(apiObject.Id),
+			"resource":               aws.ToString(apiObject.Resource),
+			"resource_owner_account": aws.ToString(apiObject.ResourceOwnerAccount),
+			"resource_type":          string(apiObject.ResourceType),
+			"status":                 string(apiObject.Status),
+		}
+
+		if v := apiObject.AnalyzedAt; v != nil {
+			tfMap["analyzed_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.CreatedAt; v != nil {
+			tfMap["created_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.UpdatedAt; v != nil {
+			tfMap["updated_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFindingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_accessanalyzer_findings.test"
+	resourceName := "aws_accessanalyzer_analyzer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalyzerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "analyzer_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "finding_type", "ExternalAccess"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ids.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_type", "AWS::S3::Bucket"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+}
+
+data "aws_accessanalyzer_findings" "test" {
+  analyzer_arn  = aws_accessanalyzer_analyzer.test.arn
+  finding_type  = "ExternalAccess"
+  resource_type = "AWS::S3::Bucket"
+  status        = "ACTIVE"
+}
+`, rName)
+}
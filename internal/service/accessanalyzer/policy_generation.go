@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_accessanalyzer_policy_generation", name="Policy Generation")
+func resourcePolicyGeneration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePolicyGenerationCreate,
+		ReadWithoutTimeout:   resourcePolicyGenerationRead,
+		DeleteWithoutTimeout: resourcePolicyGenerationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloudtrail_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+						"start_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+						"trail": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"all_regions": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"cloudtrail_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"regions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"completed_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"generated_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include_resource_placeholders": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"include_service_level_template": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"started_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePolicyGenerationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	principalARN := d.Get("principal_arn").(string)
+	input := &accessanalyzer.StartPolicyGenerationInput{
+		ClientToken: aws.String(id.UniqueId()),
+		PolicyGenerationDetails: &types.PolicyGenerationDetails{
+			PrincipalArn: aws.String(principalARN),
+		},
+	}
+
+	if v, ok := d.GetOk("cloudtrail_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CloudTrailDetails = expandCloudTrailDetails(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.StartPolicyGeneration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting IAM Access Analyzer Policy Generation (%s): %s", principalARN, err)
+	}
+
+	d.SetId(aws.ToString(output.JobId))
+
+	if _, err := waitPolicyGenerationSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM Access Analyzer Policy Generation (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePolicyGenerationRead(ctx, d, meta)...)
+}
+
+func resourcePolicyGenerationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	input := &accessanalyzer.GetGeneratedPolicyInput{
+		IncludeResourcePlaceholders: aws.Bool(d.Get("include_resource_placeholders").(bool)),
+		IncludeServiceLevelTemplate: aws.Bool(d.Get("include_service_level_template").(bool)),
+		JobId:                       aws.String(d.Id()),
+	}
+
+	output, err := findGeneratedPolicy(ctx, conn, input)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Access Analyzer Policy Generation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Access Analyzer Policy Generation (%s): %s", d.Id(), err)
+	}
+
+	job := output.JobDetails
+	if v := job.CompletedOn; v != nil {
+		d.Set("completed_on", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("completed_on", nil)
+	}
+	if v := job.StartedOn; v != nil {
+		d.Set("started_on", aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set("status", job.Status)
+
+	var policies []string
+	if v := output.GeneratedPolicyResult; v != nil {
+		for _, v := range v.GeneratedPolicies {
+			policies = append(policies, aws.ToString(v.Policy))
+		}
+
+		if v := v.Properties; v != nil {
+			d.Set("principal_arn", v.PrincipalArn)
+		}
+	}
+	d.Set("generated_policies", policies)
+
+	return diags
+}
+
+func resourcePolicyGenerationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	// Completed policy generation jobs cannot be deleted and expire automatically.
+	if d.Get("status").(string) != string(types.JobStatusInProgress) {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Canceling IAM Access Analyzer Policy Generation: %s", d.Id())
+	_, err := conn.CancelPolicyGeneration(ctx, &accessanalyzer.CancelPolicyGenerationInput{
+		JobId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "canceling IAM Access Analyzer Policy Generation (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findGeneratedPolicy(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.GetGeneratedPolicyInput) (*accessanalyzer.GetGeneratedPolicyOutput, error) {
+	output, err := conn.GetGeneratedPolicy(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findPolicyGenerationByID(ctx context.Context, conn *accessanalyzer.Client, id string) (*types.JobDetails, error) {
+	input := &accessanalyzer.GetGeneratedPolicyInput{
+		JobId: aws.String(id),
+	}
+
+	output, err := findGeneratedPolicy(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.JobDetails, nil
+}
+
+func statusPolicyGeneration(ctx context.Context, conn *accessanalyzer.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPolicyGenerationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitPolicyGenerationSucceeded(ctx context.Context, conn *accessanalyzer.Client, id string, timeout time.Duration) (*types.JobDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.JobStatusInProgress),
+		Target:  enum.Slice(types.JobStatusSucceeded),
+		Refresh: statusPolicyGeneration(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDetails); ok {
+		if v := output.JobError; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", v.Code, aws.ToString(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandCloudTrailDetails(tfMap map[string]interface{}) *types.CloudTrailDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CloudTrailDetails{}
+
+	if v, ok := tfMap["access_role_arn"].(string); ok && v != "" {
+		apiObject.AccessRole = aws.String(v)
+	}
+
+	if v, ok := tfMap["end_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.EndTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["start_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.StartTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["trail"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Trails = expandTrails(v)
+	}
+
+	return apiObject
+}
+
+func expandTrails(tfList []interface{}) []types.Trail {
+	var apiObjects []types.Trail
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.Trail{}
+
+		if v, ok := tfMap["all_regions"].(bool); ok && v {
+			apiObject.AllRegions = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["cloudtrail_arn"].(string); ok && v != "" {
+			apiObject.CloudTrailArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Regions = flex.ExpandStringValueSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
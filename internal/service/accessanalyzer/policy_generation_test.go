@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerPolicyGeneration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job types.JobDetails
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_policy_generation.test"
+	startTime := time.Now().UTC().Add(-24 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyGenerationConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyGenerationExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttrSet(resourceName, "completed_on"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_arn", "aws_iam_role.principal", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "started_on"),
+					resource.TestCheckResourceAttr(resourceName, "status", string(types.JobStatusSucceeded)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cloudtrail_details"},
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyGenerationExists(ctx context.Context, n string, v *types.JobDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IAM Access Analyzer Policy Generation ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+		output, err := tfaccessanalyzer.FindPolicyGenerationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPolicyGenerationConfig_basic(rName, startTime string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid    = "AWSCloudTrailAclCheck"
+        Effect = "Allow"
+        Principal = {
+          Service = "cloudtrail.${data.aws_partition.current.dns_suffix}"
+        }
+        Action   = "s3:GetBucketAcl"
+        Resource = aws_s3_bucket.test.arn
+      },
+      {
+        Sid    = "AWSCloudTrailWrite"
+        Effect = "Allow"
+        Principal = {
+          Service = "cloudtrail.${data.aws_partition.current.dns_suffix}"
+        }
+        Action   = "s3:PutObject"
+        Resource = "${aws_s3_bucket.test.arn}/*"
+        Condition = {
+          StringEquals = {
+            "s3:x-amz-acl" = "bucket-owner-full-control"
+          }
+        }
+      }
+    ]
+  })
+}
+
+resource "aws_cloudtrail" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.id
+}
+
+resource "aws_iam_role" "principal" {
+  name = "%[1]s-principal"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role" "access" {
+  name = "%[1]s-access"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "access-analyzer.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "access" {
+  role = aws_iam_role.access.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = ["cloudtrail:GetTrail", "iam:GetServiceLastAccessedDetails", "iam:GenerateServiceLastAccessedDetails"]
+        Resource = "*"
+      },
+      {
+        Effect   = "Allow"
+        Action   = ["s3:GetObject", "s3:ListBucket"]
+        Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+      }
+    ]
+  })
+}
+
+resource "aws_accessanalyzer_policy_generation" "test" {
+  depends_on = [aws_iam_role_policy.access]
+
+  principal_arn = aws_iam_role.principal.arn
+
+  cloudtrail_details {
+    access_role_arn = aws_iam_role.access.arn
+    start_time      = %[2]q
+
+    trail {
+      cloudtrail_arn = aws_cloudtrail.test.arn
+      regions        = [data.aws_region.current.name]
+    }
+  }
+}
+`, rName, startTime)
+}
@@ -23,7 +23,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAnalyzer,
+			TypeName: "aws_accessanalyzer_analyzer",
+		},
+		{
+			Factory:  dataSourceFindings,
+			TypeName: "aws_accessanalyzer_findings",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -40,6 +49,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceArchiveRule,
 			TypeName: "aws_accessanalyzer_archive_rule",
 		},
+		{
+			Factory:  resourcePolicyGeneration,
+			TypeName: "aws_accessanalyzer_policy_generation",
+			Name:     "Policy Generation",
+		},
 	}
 }
 
@@ -7,18 +7,18 @@
 package acm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/acm"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_acm_certificate", &sweep.Sweeper{
 		Name: "aws_acm_certificate",
 		F:    sweepCertificates,
 		Dependencies: []string{
@@ -41,8 +41,7 @@ func init() {
 	})
 }
 
-func sweepCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,24 +7,23 @@
 package amplify
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/amplify"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_amplify_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_amplify_app", &sweep.Sweeper{
 		Name: "aws_amplify_app",
 		F:    sweepApps,
 	})
 }
 
-func sweepApps(region string) error {
-	ctx := sweep.Context(region)
+func sweepApps(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,6 +7,7 @@
 package apigateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -16,32 +17,31 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_rest_api", &sweep.Sweeper{
 		Name: "aws_api_gateway_rest_api",
 		F:    sweepRestAPIs,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_vpc_link", &sweep.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    sweepVPCLinks,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_client_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_client_certificate", &sweep.Sweeper{
 		Name: "aws_api_gateway_client_certificate",
 		F:    sweepClientCertificates,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_usage_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_usage_plan", &sweep.Sweeper{
 		Name: "aws_api_gateway_usage_plan",
 		F:    sweepUsagePlans,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_api_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_api_key", &sweep.Sweeper{
 		Name: "aws_api_gateway_api_key",
 		F:    sweepAPIKeys,
 		Dependencies: []string{
@@ -49,14 +49,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_domain_name", &sweep.Sweeper{
 		Name: "aws_api_gateway_domain_name",
 		F:    sweepDomainNames,
 	})
 }
 
-func sweepRestAPIs(region string) error {
-	ctx := sweep.Context(region)
+func sweepRestAPIs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -98,8 +97,7 @@ func sweepRestAPIs(region string) error {
 	return nil
 }
 
-func sweepVPCLinks(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCLinks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -136,8 +134,7 @@ func sweepVPCLinks(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepClientCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepClientCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -179,8 +176,7 @@ func sweepClientCertificates(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepUsagePlans(region string) error {
-	ctx := sweep.Context(region)
+func sweepUsagePlans(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -227,8 +223,7 @@ func sweepUsagePlans(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepAPIKeys(region string) error {
-	ctx := sweep.Context(region)
+func sweepAPIKeys(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -274,8 +269,7 @@ func sweepAPIKeys(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
+func sweepDomainNames(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -7,18 +7,18 @@
 package apigatewayv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_api", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_api",
 		F:    sweepAPIs,
 		Dependencies: []string{
@@ -26,12 +26,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_api_mapping", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_api_mapping", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_api_mapping",
 		F:    sweepAPIMappings,
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_domain_name", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_domain_name",
 		F:    sweepDomainNames,
 		Dependencies: []string{
@@ -39,14 +39,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_vpc_link", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_vpc_link",
 		F:    sweepVPCLinks,
 	})
 }
 
-func sweepAPIs(region string) error {
-	ctx := sweep.Context(region)
+func sweepAPIs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -89,8 +88,7 @@ func sweepAPIs(region string) error {
 	return nil
 }
 
-func sweepAPIMappings(region string) error {
-	ctx := sweep.Context(region)
+func sweepAPIMappings(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -158,8 +156,7 @@ func sweepAPIMappings(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
+func sweepDomainNames(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -202,8 +199,7 @@ func sweepDomainNames(region string) error {
 	return nil
 }
 
-func sweepVPCLinks(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCLinks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,6 +7,7 @@
 package appconfig
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,13 +15,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appconfig_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appconfig_application", &sweep.Sweeper{
 		Name: "aws_appconfig_application",
 		F:    sweepApplications,
 		Dependencies: []string{
@@ -29,7 +29,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appconfig_configuration_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appconfig_configuration_profile", &sweep.Sweeper{
 		Name: "aws_appconfig_configuration_profile",
 		F:    sweepConfigurationProfiles,
 		Dependencies: []string{
@@ -37,24 +37,23 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appconfig_deployment_strategy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appconfig_deployment_strategy", &sweep.Sweeper{
 		Name: "aws_appconfig_deployment_strategy",
 		F:    sweepDeploymentStrategies,
 	})
 
-	resource.AddTestSweepers("aws_appconfig_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appconfig_environment", &sweep.Sweeper{
 		Name: "aws_appconfig_environment",
 		F:    sweepEnvironments,
 	})
 
-	resource.AddTestSweepers("aws_appconfig_hosted_configuration_version", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appconfig_hosted_configuration_version", &sweep.Sweeper{
 		Name: "aws_appconfig_hosted_configuration_version",
 		F:    sweepHostedConfigurationVersions,
 	})
 }
 
-func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
+func sweepApplications(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -106,8 +105,7 @@ func sweepApplications(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepConfigurationProfiles(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigurationProfiles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -183,8 +181,7 @@ func sweepConfigurationProfiles(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepDeploymentStrategies(region string) error {
-	ctx := sweep.Context(region)
+func sweepDeploymentStrategies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -242,8 +239,7 @@ func sweepDeploymentStrategies(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepEnvironments(region string) error {
-	ctx := sweep.Context(region)
+func sweepEnvironments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -315,8 +311,7 @@ func sweepEnvironments(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepHostedConfigurationVersions(region string) error {
-	ctx := sweep.Context(region)
+func sweepHostedConfigurationVersions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -7,25 +7,24 @@
 package applicationinsights
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/applicationinsights"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_applicationinsights_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_applicationinsights_application", &sweep.Sweeper{
 		Name: "aws_applicationinsights_application",
 		F:    sweepApplications,
 	})
 }
 
-func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
+func sweepApplications(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
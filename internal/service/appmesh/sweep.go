@@ -7,23 +7,23 @@
 package appmesh
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_gateway_route", &sweep.Sweeper{
 		Name: "aws_appmesh_gateway_route",
 		F:    sweepGatewayRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_mesh", &sweep.Sweeper{
 		Name: "aws_appmesh_mesh",
 		F:    sweepMeshes,
 		Dependencies: []string{
@@ -34,12 +34,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_route", &sweep.Sweeper{
 		Name: "aws_appmesh_route",
 		F:    sweepRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_gateway", &sweep.Sweeper{
 		Name: "aws_appmesh_virtual_gateway",
 		F:    sweepVirtualGateways,
 		Dependencies: []string{
@@ -47,12 +47,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_node", &sweep.Sweeper{
 		Name: "aws_appmesh_virtual_node",
 		F:    sweepVirtualNodes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_router", &sweep.Sweeper{
 		Name: "aws_appmesh_virtual_router",
 		F:    sweepVirtualRouters,
 		Dependencies: []string{
@@ -60,14 +60,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_service", &sweep.Sweeper{
 		Name: "aws_appmesh_virtual_service",
 		F:    sweepVirtualServices,
 	})
 }
 
-func sweepMeshes(region string) error {
-	ctx := sweep.Context(region)
+func sweepMeshes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -110,8 +109,7 @@ func sweepMeshes(region string) error {
 	return nil
 }
 
-func sweepVirtualGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepVirtualGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -181,8 +179,7 @@ func sweepVirtualGateways(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualNodes(region string) error {
-	ctx := sweep.Context(region)
+func sweepVirtualNodes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -252,8 +249,7 @@ func sweepVirtualNodes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualRouters(region string) error {
-	ctx := sweep.Context(region)
+func sweepVirtualRouters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -323,8 +319,7 @@ func sweepVirtualRouters(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepVirtualServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -394,8 +389,7 @@ func sweepVirtualServices(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepGatewayRoutes(region string) error {
-	ctx := sweep.Context(region)
+func sweepGatewayRoutes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -490,8 +484,7 @@ func sweepGatewayRoutes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRoutes(region string) error {
-	ctx := sweep.Context(region)
+func sweepRoutes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
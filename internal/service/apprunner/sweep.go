@@ -7,37 +7,36 @@
 package apprunner
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apprunner"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &sweep.Sweeper{
 		Name:         "aws_apprunner_auto_scaling_configuration_version",
 		F:            sweepAutoScalingConfigurationVersions,
 		Dependencies: []string{"aws_apprunner_service"},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_connection", &sweep.Sweeper{
 		Name:         "aws_apprunner_connection",
 		F:            sweepConnections,
 		Dependencies: []string{"aws_apprunner_service"},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_service", &sweep.Sweeper{
 		Name: "aws_apprunner_service",
 		F:    sweepServices,
 	})
 }
 
-func sweepAutoScalingConfigurationVersions(region string) error {
-	ctx := sweep.Context(region)
+func sweepAutoScalingConfigurationVersions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -102,8 +101,7 @@ func sweepAutoScalingConfigurationVersions(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -163,8 +161,7 @@ func sweepConnections(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -7,39 +7,38 @@
 package appstream
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appstream"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_directory_config", &sweep.Sweeper{
 		Name: "aws_appstream_directory_config",
 		F:    sweepDirectoryConfigs,
 	})
 
-	resource.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_fleet", &sweep.Sweeper{
 		Name: "aws_appstream_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_image_builder", &sweep.Sweeper{
 		Name: "aws_appstream_image_builder",
 		F:    sweepImageBuilders,
 	})
 
-	resource.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_stack", &sweep.Sweeper{
 		Name: "aws_appstream_stack",
 		F:    sweepStacks,
 	})
 }
 
-func sweepDirectoryConfigs(region string) error {
-	ctx := sweep.Context(region)
+func sweepDirectoryConfigs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -82,8 +81,7 @@ func sweepDirectoryConfigs(region string) error {
 	return nil
 }
 
-func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
+func sweepFleets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -126,8 +124,7 @@ func sweepFleets(region string) error {
 	return nil
 }
 
-func sweepImageBuilders(region string) error {
-	ctx := sweep.Context(region)
+func sweepImageBuilders(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -170,8 +167,7 @@ func sweepImageBuilders(region string) error {
 	return nil
 }
 
-func sweepStacks(region string) error {
-	ctx := sweep.Context(region)
+func sweepStacks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -7,25 +7,24 @@
 package athena
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_athena_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_athena_database", &sweep.Sweeper{
 		Name: "aws_athena_database",
 		F:    sweepDatabases,
 	})
 }
 
-func sweepDatabases(region string) error {
-	ctx := sweep.Context(region)
+func sweepDatabases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -20,6 +20,7 @@ import (
 )
 
 // @FrameworkResource
+// @NoSweeper("account-level setting")
 func newResourceAccountRegistration(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceAccountRegistration{}, nil
 }
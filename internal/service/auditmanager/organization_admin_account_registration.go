@@ -21,6 +21,7 @@ import (
 )
 
 // @FrameworkResource
+// @NoSweeper("account-level setting")
 func newResourceOrganizationAdminAccountRegistration(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceOrganizationAdminAccountRegistration{}, nil
 }
@@ -7,6 +7,7 @@
 package auditmanager
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -14,14 +15,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_auditmanager_assessment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_assessment", &sweep.Sweeper{
 		Name: "aws_auditmanager_assessment",
 		F:    sweepAssessments,
 		Dependencies: []string{
@@ -31,23 +31,23 @@ func init() {
 			"aws_s3_bucket",
 		},
 	})
-	resource.AddTestSweepers("aws_auditmanager_assessment_delegation", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_assessment_delegation", &sweep.Sweeper{
 		Name: "aws_auditmanager_assessment_delegation",
 		F:    sweepAssessmentDelegations,
 	})
-	resource.AddTestSweepers("aws_auditmanager_assessment_report", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_assessment_report", &sweep.Sweeper{
 		Name: "aws_auditmanager_assessment_report",
 		F:    sweepAssessmentReports,
 	})
-	resource.AddTestSweepers("aws_auditmanager_control", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_control", &sweep.Sweeper{
 		Name: "aws_auditmanager_control",
 		F:    sweepControls,
 	})
-	resource.AddTestSweepers("aws_auditmanager_framework", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_framework", &sweep.Sweeper{
 		Name: "aws_auditmanager_framework",
 		F:    sweepFrameworks,
 	})
-	resource.AddTestSweepers("aws_auditmanager_framework_share", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_framework_share", &sweep.Sweeper{
 		Name: "aws_auditmanager_framework_share",
 		F:    sweepFrameworkShares,
 	})
@@ -66,8 +66,7 @@ func isCompleteSetupError(err error) bool {
 	return false
 }
 
-func sweepAssessments(region string) error {
-	ctx := sweep.Context(region)
+func sweepAssessments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -106,8 +105,7 @@ func sweepAssessments(region string) error {
 	return nil
 }
 
-func sweepAssessmentDelegations(region string) error {
-	ctx := sweep.Context(region)
+func sweepAssessmentDelegations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -145,8 +143,7 @@ func sweepAssessmentDelegations(region string) error {
 	return nil
 }
 
-func sweepAssessmentReports(region string) error {
-	ctx := sweep.Context(region)
+func sweepAssessmentReports(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -186,8 +183,7 @@ func sweepAssessmentReports(region string) error {
 	return nil
 }
 
-func sweepControls(region string) error {
-	ctx := sweep.Context(region)
+func sweepControls(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -226,8 +222,7 @@ func sweepControls(region string) error {
 	return nil
 }
 
-func sweepFrameworks(region string) error {
-	ctx := sweep.Context(region)
+func sweepFrameworks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -266,8 +261,7 @@ func sweepFrameworks(region string) error {
 	return nil
 }
 
-func sweepFrameworkShares(region string) error {
-	ctx := sweep.Context(region)
+func sweepFrameworkShares(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/servicepackage/main.go; DO NOT EDIT.
+
+//go:build sweep
+// +build sweep
+
+package auditmanager
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	framework.Exclude(newResourceAccountRegistration, "account-level setting")
+	framework.Exclude(newResourceOrganizationAdminAccountRegistration, "account-level setting")
+}
@@ -7,43 +7,43 @@
 package backup
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_backup_framework", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_framework", &sweep.Sweeper{
 		Name: "aws_backup_framework",
 		F:    sweepFramework,
 	})
 
-	resource.AddTestSweepers("aws_backup_report_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_report_plan", &sweep.Sweeper{
 		Name: "aws_backup_report_plan",
 		F:    sweepReportPlan,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_lock_configuration", &sweep.Sweeper{
 		Name: "aws_backup_vault_lock_configuration",
 		F:    sweepVaultLockConfiguration,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_notifications", &sweep.Sweeper{
 		Name: "aws_backup_vault_notifications",
 		F:    sweepVaultNotifications,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_policy", &sweep.Sweeper{
 		Name: "aws_backup_vault_policy",
 		F:    sweepVaultPolicies,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault", &sweep.Sweeper{
 		Name: "aws_backup_vault",
 		F:    sweepVaults,
 		Dependencies: []string{
@@ -54,8 +54,7 @@ func init() {
 	})
 }
 
-func sweepFramework(region string) error {
-	ctx := sweep.Context(region)
+func sweepFramework(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -97,8 +96,7 @@ func sweepFramework(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepReportPlan(region string) error {
-	ctx := sweep.Context(region)
+func sweepReportPlan(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -140,8 +138,7 @@ func sweepReportPlan(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVaultLockConfiguration(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaultLockConfiguration(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -190,8 +187,7 @@ func sweepVaultLockConfiguration(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepVaultNotifications(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaultNotifications(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -240,8 +236,7 @@ func sweepVaultNotifications(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepVaultPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaultPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -283,8 +278,7 @@ func sweepVaultPolicies(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVaults(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaults(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -7,23 +7,23 @@
 package budgets
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/budgets"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_budgets_budget_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_budgets_budget_action", &sweep.Sweeper{
 		Name: "aws_budgets_budget_action",
 		F:    sweepBudgetActions,
 	})
 
-	resource.AddTestSweepers("aws_budgets_budget", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_budgets_budget", &sweep.Sweeper{
 		Name: "aws_budgets_budget",
 		F:    sweepBudgets,
 		Dependencies: []string{
@@ -32,8 +32,7 @@ func init() {
 	})
 }
 
-func sweepBudgetActions(region string) error {
-	ctx := sweep.Context(region)
+func sweepBudgetActions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -79,8 +78,7 @@ func sweepBudgetActions(region string) error {
 	return nil
 }
 
-func sweepBudgets(region string) error { // nosemgrep:ci.budgets-in-func-name
-	ctx := sweep.Context(region)
+func sweepBudgets(ctx context.Context, region string) error { // nosemgrep:ci.budgets-in-func-name
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mattbaird/jsonpatch"
+)
+
+// propertyChange is a property-level change between two desired states.
+type propertyChange struct {
+	forceNew  bool
+	operation string // JSON Patch operation: "add", "remove" or "replace".
+	path      string // JSON Pointer to the property, e.g. "/Tags/0/Value".
+}
+
+// diffDesiredState returns the property-level changes between two desired states of a resource.
+// Changes to the resource's read-only properties are ignored and changes to its create-only properties force replacement.
+// resource may be nil if the resource schema is not known.
+func diffDesiredState(resource *cfschema.Resource, old, new string) ([]propertyChange, error) {
+	oldDoc, err := desiredStateWithoutReadOnlyProperties(resource, old)
+
+	if err != nil {
+		return nil, err
+	}
+
+	newDoc, err := desiredStateWithoutReadOnlyProperties(resource, new)
+
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := createPatch(oldDoc, newDoc)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Report changes by property. The patch itself is never reordered, see createPatch.
+	sort.Stable(jsonpatch.ByPath(patch))
+
+	var changes []propertyChange
+
+	for _, v := range patch {
+		change := propertyChange{
+			operation: v.Operation,
+			path:      v.Path,
+		}
+
+		if resource != nil {
+			for _, ptr := range resource.CreateOnlyProperties {
+				if isCreateOnlyChange(ptr.Path(), pointerSegments(v.Path), oldDoc, newDoc) {
+					change.forceNew = true
+					break
+				}
+			}
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func flattenPropertyChanges(apiObjects []propertyChange) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"operation": apiObject.operation,
+			"path":      apiObject.path,
+		})
+	}
+
+	return tfList
+}
+
+// desiredStatePatchDocument returns a JSON Patch document describing the difference between two desired states,
+// excluding any read-only properties.
+func desiredStatePatchDocument(resource *cfschema.Resource, old, new string) (string, error) {
+	oldDoc, err := desiredStateWithoutReadOnlyProperties(resource, old)
+
+	if err != nil {
+		return "", err
+	}
+
+	newDoc, err := desiredStateWithoutReadOnlyProperties(resource, new)
+
+	if err != nil {
+		return "", err
+	}
+
+	patch, err := createPatch(oldDoc, newDoc)
+
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(patch)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// suppressEquivalentDesiredState suppresses desired_state diffs that contain no property-level changes,
+// e.g. differences in formatting or key order, or changes to read-only properties.
+func suppressEquivalentDesiredState(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	// The resource schema is only used to ignore read-only properties.
+	resource, _ := resourceSchema(d.Get("schema").(string))
+
+	changes, err := diffDesiredState(resource, old, new)
+
+	return err == nil && len(changes) == 0
+}
+
+// resourceSchema returns the resource definition from a CloudFormation resource schema document.
+// Returns nil if the document is empty.
+func resourceSchema(document string) (*cfschema.Resource, error) {
+	if document == "" {
+		return nil, nil
+	}
+
+	document, err := cfschema.Sanitize(document)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resourceJSONSchema, err := cfschema.NewResourceJsonSchemaDocument(document)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resourceJSONSchema.Resource()
+}
+
+func desiredStateWithoutReadOnlyProperties(resource *cfschema.Resource, desiredState string) (map[string]interface{}, error) {
+	var doc map[string]interface{}
+
+	if err := json.Unmarshal([]byte(desiredState), &doc); err != nil {
+		return nil, err
+	}
+
+	if resource != nil {
+		for _, ptr := range resource.ReadOnlyProperties {
+			removePath(doc, ptr.Path())
+		}
+	}
+
+	return doc, nil
+}
+
+func createPatch(old, new map[string]interface{}) ([]jsonpatch.JsonPatchOperation, error) {
+	a, err := json.Marshal(old)
+
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(new)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The operations must be applied in the order in which they are generated:
+	// array elements are removed in descending index order and before any elements are added.
+	return jsonpatch.CreatePatch(a, b)
+}
+
+// isCreateOnlyChange returns whether a change at the specified path modifies the create-only property at ptr.
+// The change is either within the property or to one of its ancestors, in which case the property's values are compared.
+func isCreateOnlyChange(ptr, path []string, old, new map[string]interface{}) bool {
+	if hasPathPrefix(path, ptr) {
+		return true
+	}
+
+	if hasPathPrefix(ptr, path) {
+		return !reflect.DeepEqual(valuesAtPath(old, ptr), valuesAtPath(new, ptr))
+	}
+
+	return false
+}
+
+// hasPathPrefix returns whether path starts with prefix.
+// A "*" segment in either matches any single segment.
+func hasPathPrefix(path, prefix []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+
+	for i, v := range prefix {
+		if v != path[i] && v != "*" && path[i] != "*" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pointerSegments returns the unescaped segments of an RFC 6901 JSON Pointer.
+func pointerSegments(ptr string) []string {
+	segments := strings.Split(strings.TrimPrefix(ptr, "/"), "/")
+
+	for i, v := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(v, "~1", "/"), "~0", "~")
+	}
+
+	return segments
+}
+
+// valuesAtPath returns all values at the specified path. A "*" segment matches every array element.
+func valuesAtPath(v interface{}, path []string) []interface{} {
+	if len(path) == 0 {
+		return []interface{}{v}
+	}
+
+	var values []interface{}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if v, ok := v[path[0]]; ok {
+			values = append(values, valuesAtPath(v, path[1:])...)
+		}
+	case []interface{}:
+		for i, e := range v {
+			if segment := path[0]; segment == "*" || segment == strconv.Itoa(i) {
+				values = append(values, valuesAtPath(e, path[1:])...)
+			}
+		}
+	}
+
+	return values
+}
+
+// removePath removes all values at the specified path. A "*" segment matches every array element.
+func removePath(v interface{}, path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			delete(v, path[0])
+			return
+		}
+
+		if e, ok := v[path[0]]; ok {
+			removePath(e, path[1:])
+		}
+	case []interface{}:
+		for i, e := range v {
+			if segment := path[0]; segment == "*" || segment == strconv.Itoa(i) {
+				removePath(e, path[1:])
+			}
+		}
+	}
+}
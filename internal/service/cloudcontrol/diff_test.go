@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"reflect"
+	"testing"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+)
+
+func TestDiffDesiredState(t *testing.T) {
+	t.Parallel()
+
+	resource := &cfschema.Resource{
+		CreateOnlyProperties: cfschema.PropertyJsonPointers{"/properties/Name", "/properties/Encryption/KmsKeyId"},
+		ReadOnlyProperties:   cfschema.PropertyJsonPointers{"/properties/Arn", "/properties/Endpoint/Address"},
+	}
+
+	testCases := []struct {
+		TestName string
+		Resource *cfschema.Resource
+		Old      string
+		New      string
+		Expected []propertyChange
+	}{
+		{
+			TestName: "equivalent",
+			Resource: resource,
+			Old:      `{"Name":"a","Size":1}`,
+			New:      `{"Size": 1, "Name": "a"}`,
+		},
+		{
+			TestName: "read-only property",
+			Resource: resource,
+			Old:      `{"Name":"a","Arn":"arn:1","Endpoint":{"Address":"x","Port":80}}`,
+			New:      `{"Name":"a","Endpoint":{"Port":80}}`,
+		},
+		{
+			TestName: "updatable property",
+			Resource: resource,
+			Old:      `{"Name":"a","Size":1}`,
+			New:      `{"Name":"a","Size":2}`,
+			Expected: []propertyChange{
+				{operation: "replace", path: "/Size"},
+			},
+		},
+		{
+			TestName: "create-only property",
+			Resource: resource,
+			Old:      `{"Name":"a","Size":1}`,
+			New:      `{"Name":"b","Size":1}`,
+			Expected: []propertyChange{
+				{forceNew: true, operation: "replace", path: "/Name"},
+			},
+		},
+		{
+			TestName: "nested create-only property",
+			Resource: resource,
+			Old:      `{"Name":"a","Encryption":{"KmsKeyId":"k1","Enabled":true}}`,
+			New:      `{"Name":"a","Encryption":{"KmsKeyId":"k2","Enabled":true}}`,
+			Expected: []propertyChange{
+				{forceNew: true, operation: "replace", path: "/Encryption/KmsKeyId"},
+			},
+		},
+		{
+			TestName: "create-only property ancestor removed",
+			Resource: resource,
+			Old:      `{"Name":"a","Encryption":{"KmsKeyId":"k1"}}`,
+			New:      `{"Name":"a"}`,
+			Expected: []propertyChange{
+				{forceNew: true, operation: "remove", path: "/Encryption"},
+			},
+		},
+		{
+			TestName: "create-only property sibling changed",
+			Resource: resource,
+			Old:      `{"Name":"a","Encryption":{"KmsKeyId":"k1","Enabled":true}}`,
+			New:      `{"Name":"a","Encryption":{"KmsKeyId":"k1","Enabled":false}}`,
+			Expected: []propertyChange{
+				{operation: "replace", path: "/Encryption/Enabled"},
+			},
+		},
+		{
+			TestName: "no resource schema",
+			Old:      `{"Name":"a","Arn":"arn:1"}`,
+			New:      `{"Name":"b"}`,
+			Expected: []propertyChange{
+				{operation: "remove", path: "/Arn"},
+				{operation: "replace", path: "/Name"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := diffDesiredState(testCase.Resource, testCase.Old, testCase.New)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %+v, expected %+v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDesiredStatePatchDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Resource *cfschema.Resource
+		Old      string
+		New      string
+		Expected string
+	}{
+		{
+			TestName: "read-only property",
+			Resource: &cfschema.Resource{
+				ReadOnlyProperties: cfschema.PropertyJsonPointers{"/properties/Arn"},
+			},
+			Old:      `{"Arn":"arn:1","Size":1}`,
+			New:      `{"Arn":"arn:2","Size":2}`,
+			Expected: `[{"op":"replace","path":"/Size","value":2}]`,
+		},
+		{
+			TestName: "remove array elements",
+			Old:      `{"Tags":[{"Key":"a"},{"Key":"b"},{"Key":"c"}]}`,
+			New:      `{"Tags":[{"Key":"a"}]}`,
+			Expected: `[{"op":"remove","path":"/Tags/2"},{"op":"remove","path":"/Tags/1"}]`,
+		},
+		{
+			TestName: "remove array elements multi-digit index",
+			Old:      `{"Ids":[0,1,2,3,4,5,6,7,8,9,10,11]}`,
+			New:      `{"Ids":[0,1,2,3,4,5,6,7,8,9]}`,
+			Expected: `[{"op":"remove","path":"/Ids/11"},{"op":"remove","path":"/Ids/10"}]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := desiredStatePatchDocument(testCase.Resource, testCase.Old, testCase.New)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFlattenPropertyChanges(t *testing.T) {
+	t.Parallel()
+
+	changes := []propertyChange{
+		{operation: "remove", path: "/Encryption"},
+		{forceNew: true, operation: "replace", path: "/Name"},
+	}
+	expected := []interface{}{
+		map[string]interface{}{"operation": "remove", "path": "/Encryption"},
+		map[string]interface{}{"operation": "replace", "path": "/Name"},
+	}
+
+	if got := flattenPropertyChanges(changes); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %+v, expected %+v", got, expected)
+	}
+}
@@ -5,7 +5,6 @@ package cloudcontrol
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -26,7 +25,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	"github.com/mattbaird/jsonpatch"
 )
 
 // @SDKResource("aws_cloudcontrolapi_resource")
@@ -45,13 +43,30 @@ func ResourceResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"desired_state": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentDesiredState,
 			},
 			"properties": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"property_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"role_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -157,7 +172,13 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	if d.HasChange("desired_state") {
 		oldRaw, newRaw := d.GetChange("desired_state")
 
-		patchDocument, err := patchDocument(oldRaw.(string), newRaw.(string))
+		cfResource, err := resourceSchema(d.Get("schema").(string))
+
+		if err != nil {
+			return diag.Errorf("parsing CloudFormation Resource Schema JSON: %s", err)
+		}
+
+		patchDocument, err := desiredStatePatchDocument(cfResource, oldRaw.(string), newRaw.(string))
 
 		if err != nil {
 			return diag.Errorf("creating JSON Patch: %s", err)
@@ -297,14 +318,20 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
 	}
 
-	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredStateRaw.(string)))
+	changes, err := diffDesiredState(cfResource, oldDesiredStateRaw.(string), newDesiredState)
 
 	if err != nil {
-		return fmt.Errorf("creating desired_state JSON Patch: %w", err)
+		return fmt.Errorf("comparing desired_state: %w", err)
+	}
+
+	if len(changes) > 0 {
+		if err := diff.SetNew("property_changes", flattenPropertyChanges(changes)); err != nil {
+			return fmt.Errorf("setting property_changes New: %w", err)
+		}
 	}
 
-	for _, patch := range patches {
-		if cfResource.IsCreateOnlyPropertyPath(patch.Path) {
+	for _, change := range changes {
+		if change.forceNew {
 			if err := diff.ForceNew("desired_state"); err != nil {
 				return fmt.Errorf("setting desired_state ForceNew: %w", err)
 			}
@@ -418,20 +445,3 @@ func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcon
 
 	return nil, err
 }
-
-// patchDocument returns a JSON Patch document describing the difference between `old` and `new`.
-func patchDocument(old, new string) (string, error) {
-	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
-
-	if err != nil {
-		return "", err
-	}
-
-	b, err := json.Marshal(patch)
-
-	if err != nil {
-		return "", err
-	}
-
-	return string(b), nil
-}
@@ -150,6 +150,9 @@ func TestAccCloudControlResource_DesiredState_booleanValueUpdate(t *testing.T) {
 				Config: testAccResourceConfig_desiredStateBooleanValue(rName, false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestMatchResourceAttr(resourceName, "properties", regexache.MustCompile(`"Enabled":false`)),
+					resource.TestCheckResourceAttr(resourceName, "property_changes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "property_changes.0.operation", "replace"),
+					resource.TestCheckResourceAttr(resourceName, "property_changes.0.path", "/Enabled"),
 				),
 			},
 		},
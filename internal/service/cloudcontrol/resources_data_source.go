@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKDataSource("aws_cloudcontrolapi_resources")
+func DataSourceResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"properties": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+			},
+			"type_version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlClient(ctx)
+
+	typeName := d.Get("type_name").(string)
+	input := &cloudcontrol.ListResourcesInput{
+		TypeName: aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("resource_model"); ok {
+		input.ResourceModel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type_version_id"); ok {
+		input.TypeVersionId = aws.String(v.(string))
+	}
+
+	resourceDescriptions, err := findResources(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("listing Cloud Control API (%s) Resources: %s", typeName, err)
+	}
+
+	var identifiers []string
+	var resources []interface{}
+
+	for _, v := range resourceDescriptions {
+		identifiers = append(identifiers, aws.ToString(v.Identifier))
+		resources = append(resources, map[string]interface{}{
+			"identifier": aws.ToString(v.Identifier),
+			"properties": aws.ToString(v.Properties),
+		})
+	}
+
+	d.SetId(typeName)
+
+	d.Set("identifiers", identifiers)
+	if err := d.Set("resources", resources); err != nil {
+		return diag.Errorf("setting resources: %s", err)
+	}
+
+	return nil
+}
+
+func findResources(ctx context.Context, conn *cloudcontrol.Client, input *cloudcontrol.ListResourcesInput) ([]types.ResourceDescription, error) {
+	var output []types.ResourceDescription
+
+	pages := cloudcontrol.NewListResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceDescriptions...)
+	}
+
+	return output, nil
+}
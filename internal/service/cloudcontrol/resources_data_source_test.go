@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudControlResourcesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "identifiers.*", resourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resources.*", map[string]string{
+						"identifier": rName,
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "type_name", "AWS::Logs::LogGroup"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+  })
+}
+
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = aws_cloudcontrolapi_resource.test.type_name
+
+  depends_on = [aws_cloudcontrolapi_resource.test]
+}
+`, rName)
+}
@@ -28,6 +28,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceResource,
 			TypeName: "aws_cloudcontrolapi_resource",
 		},
+		{
+			Factory:  DataSourceResources,
+			TypeName: "aws_cloudcontrolapi_resources",
+		},
 	}
 }
 
@@ -28,6 +28,7 @@ import (
 )
 
 // @FrameworkResource(name="Continuous Deployment Policy")
+// @Sweeper(lister=listSweepableContinuousDeploymentPolicies, dependencies=aws_cloudfront_distribution)
 func newResourceContinuousDeploymentPolicy(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceContinuousDeploymentPolicy{}, nil
 }
@@ -7,19 +7,21 @@
 package cloudfront
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudfront_cache_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_cache_policy", &sweep.Sweeper{
 		Name: "aws_cloudfront_cache_policy",
 		F:    sweepCachePolicies,
 		Dependencies: []string{
@@ -27,17 +29,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_distribution", &sweep.Sweeper{
 		Name: "aws_cloudfront_distribution",
 		F:    sweepDistributions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &sweep.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_config",
 		F:    sweepFieldLevelEncryptionConfigs,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &sweep.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_profile",
 		F:    sweepFieldLevelEncryptionProfiles,
 		Dependencies: []string{
@@ -45,22 +47,22 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_function", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_function", &sweep.Sweeper{
 		Name: "aws_cloudfront_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_key_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_key_group", &sweep.Sweeper{
 		Name: "aws_cloudfront_key_group",
 		F:    sweepKeyGroup,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_monitoring_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_monitoring_subscription", &sweep.Sweeper{
 		Name: "aws_cloudfront_monitoring_subscription",
 		F:    sweepMonitoringSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_access_control", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_origin_access_control", &sweep.Sweeper{
 		Name: "aws_cloudfront_origin_access_control",
 		F:    sweepOriginAccessControls,
 		Dependencies: []string{
@@ -68,7 +70,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_request_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_origin_request_policy", &sweep.Sweeper{
 		Name: "aws_cloudfront_origin_request_policy",
 		F:    sweepOriginRequestPolicies,
 		Dependencies: []string{
@@ -76,12 +78,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_realtime_log_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_realtime_log_config", &sweep.Sweeper{
 		Name: "aws_cloudfront_realtime_log_config",
 		F:    sweepRealtimeLogsConfig,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_response_headers_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_response_headers_policy", &sweep.Sweeper{
 		Name: "aws_cloudfront_response_headers_policy",
 		F:    sweepResponseHeadersPolicies,
 		Dependencies: []string{
@@ -90,8 +92,7 @@ func init() {
 	})
 }
 
-func sweepCachePolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepCachePolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -150,8 +151,7 @@ func sweepCachePolicies(region string) error {
 	return nil
 }
 
-func sweepDistributions(region string) error {
-	ctx := sweep.Context(region)
+func sweepDistributions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -208,8 +208,7 @@ func sweepDistributions(region string) error {
 	return nil
 }
 
-func sweepFunctions(region string) error {
-	ctx := sweep.Context(region)
+func sweepFunctions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -266,8 +265,7 @@ func sweepFunctions(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepKeyGroup(region string) error {
-	ctx := sweep.Context(region)
+func sweepKeyGroup(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -326,8 +324,7 @@ func sweepKeyGroup(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepMonitoringSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepMonitoringSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -375,8 +372,7 @@ func sweepMonitoringSubscriptions(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRealtimeLogsConfig(region string) error {
-	ctx := sweep.Context(region)
+func sweepRealtimeLogsConfig(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -423,8 +419,7 @@ func sweepRealtimeLogsConfig(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepFieldLevelEncryptionConfigs(region string) error {
-	ctx := sweep.Context(region)
+func sweepFieldLevelEncryptionConfigs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -481,8 +476,7 @@ func sweepFieldLevelEncryptionConfigs(region string) error {
 	return nil
 }
 
-func sweepFieldLevelEncryptionProfiles(region string) error {
-	ctx := sweep.Context(region)
+func sweepFieldLevelEncryptionProfiles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -539,8 +533,7 @@ func sweepFieldLevelEncryptionProfiles(region string) error {
 	return nil
 }
 
-func sweepOriginRequestPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepOriginRequestPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -599,8 +592,7 @@ func sweepOriginRequestPolicies(region string) error {
 	return nil
 }
 
-func sweepResponseHeadersPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepResponseHeadersPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -659,8 +651,7 @@ func sweepResponseHeadersPolicies(region string) error {
 	return nil
 }
 
-func sweepOriginAccessControls(region string) error {
-	ctx := sweep.Context(region)
+func sweepOriginAccessControls(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -716,3 +707,49 @@ func sweepOriginAccessControls(region string) error {
 
 	return nil
 }
+
+func listSweepableContinuousDeploymentPolicies(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.CloudFrontConn(ctx)
+	input := &cloudfront.ListContinuousDeploymentPoliciesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for {
+		output, err := conn.ListContinuousDeploymentPoliciesWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.ContinuousDeploymentPolicyList == nil {
+			break
+		}
+
+		for _, v := range output.ContinuousDeploymentPolicyList.Items {
+			id := aws.StringValue(v.ContinuousDeploymentPolicy.Id)
+
+			policy, err := FindContinuousDeploymentPolicyByID(ctx, conn, id)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				log.Printf("[WARN] %s", err)
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceContinuousDeploymentPolicy, client,
+				framework.NewAttribute("id", id),
+				framework.NewAttribute("etag", aws.StringValue(policy.ETag)),
+			))
+		}
+
+		if aws.StringValue(output.ContinuousDeploymentPolicyList.NextMarker) == "" {
+			break
+		}
+
+		input.Marker = output.ContinuousDeploymentPolicyList.NextMarker
+	}
+
+	return sweepResources, nil
+}
@@ -7,30 +7,29 @@
 package cloudhsmv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudhsm_v2_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudhsm_v2_cluster", &sweep.Sweeper{
 		Name:         "aws_cloudhsm_v2_cluster",
 		F:            sweepClusters,
 		Dependencies: []string{"aws_cloudhsm_v2_hsm"},
 	})
 
-	resource.AddTestSweepers("aws_cloudhsm_v2_hsm", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudhsm_v2_hsm", &sweep.Sweeper{
 		Name: "aws_cloudhsm_v2_hsm",
 		F:    sweepHSMs,
 	})
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -76,8 +75,7 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepHSMs(region string) error {
-	ctx := sweep.Context(region)
+func sweepHSMs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
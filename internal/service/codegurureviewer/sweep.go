@@ -7,24 +7,23 @@
 package codegurureviewer
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codegurureviewer"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codegurureviewer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codegurureviewer", &sweep.Sweeper{
 		Name: "aws_codegurureviewer",
 		F:    sweepAssociations,
 	})
 }
 
-func sweepAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -7,24 +7,23 @@
 package codepipeline
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codepipeline"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codepipeline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codepipeline", &sweep.Sweeper{
 		Name: "aws_codepipeline",
 		F:    sweepPipelines,
 	})
 }
 
-func sweepPipelines(region string) error {
-	ctx := sweep.Context(region)
+func sweepPipelines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -7,22 +7,22 @@
 package codestarconnections
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codestarconnections"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codestarconnections_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarconnections_connection", &sweep.Sweeper{
 		Name: "aws_codestarconnections_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_codestarconnections_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarconnections_host", &sweep.Sweeper{
 		Name: "aws_codestarconnections_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -31,8 +31,7 @@ func init() {
 	})
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -75,8 +74,7 @@ func sweepConnections(region string) error {
 	return nil
 }
 
-func sweepHosts(region string) error {
-	ctx := sweep.Context(region)
+func sweepHosts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -7,24 +7,23 @@
 package codestarnotifications
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codestarnotifications"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codestarnotifications_notification_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarnotifications_notification_rule", &sweep.Sweeper{
 		Name: "aws_codestarnotifications_notification_rule",
 		F:    sweepNotificationRules,
 	})
 }
 
-func sweepNotificationRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepNotificationRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -37,6 +37,7 @@ import (
 )
 
 // @FrameworkResource
+// @NoSweeper("deleted with aws_cognito_user_pool")
 func newResourceManagedUserPoolClient(context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceManagedUserPoolClient{}, nil
 }
@@ -7,22 +7,22 @@
 package cognitoidp
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cognito_user_pool_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cognito_user_pool_domain", &sweep.Sweeper{
 		Name: "aws_cognito_user_pool_domain",
 		F:    sweepUserPoolDomains,
 	})
 
-	resource.AddTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cognito_user_pool", &sweep.Sweeper{
 		Name: "aws_cognito_user_pool",
 		F:    sweepUserPools,
 		Dependencies: []string{
@@ -31,8 +31,7 @@ func init() {
 	})
 }
 
-func sweepUserPoolDomains(region string) error {
-	ctx := sweep.Context(region)
+func sweepUserPoolDomains(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -84,8 +83,7 @@ func sweepUserPoolDomains(region string) error {
 	return nil
 }
 
-func sweepUserPools(region string) error {
-	ctx := sweep.Context(region)
+func sweepUserPools(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
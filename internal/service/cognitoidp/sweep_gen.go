@@ -0,0 +1,15 @@
+// Code generated by internal/generate/servicepackage/main.go; DO NOT EDIT.
+
+//go:build sweep
+// +build sweep
+
+package cognitoidp
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	framework.Exclude(newResourceManagedUserPoolClient, "deleted with aws_cognito_user_pool")
+	framework.Exclude(newResourceUserPoolClient, "deleted with aws_cognito_user_pool")
+}
@@ -40,6 +40,7 @@ import (
 )
 
 // @FrameworkResource
+// @NoSweeper("deleted with aws_cognito_user_pool")
 func newResourceUserPoolClient(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceUserPoolClient{}
 	r.SetMigratedFromPluginSDK(true)
@@ -7,6 +7,7 @@
 package configservice
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -15,27 +16,26 @@ import (
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_aggregate_authorization", &sweep.Sweeper{
 		Name: "aws_config_aggregate_authorization",
 		F:    sweepAggregateAuthorizations,
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_configuration_aggregator", &sweep.Sweeper{
 		Name: "aws_config_configuration_aggregator",
 		F:    sweepConfigurationAggregators,
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_configuration_recorder", &sweep.Sweeper{
 		Name: "aws_config_configuration_recorder",
 		F:    sweepConfigurationRecorder,
 	})
 
-	resource.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_delivery_channel", &sweep.Sweeper{
 		Name: "aws_config_delivery_channel",
 		Dependencies: []string{
 			"aws_config_configuration_recorder",
@@ -44,8 +44,7 @@ func init() {
 	})
 }
 
-func sweepAggregateAuthorizations(region string) error {
-	ctx := sweep.Context(region)
+func sweepAggregateAuthorizations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -82,8 +81,7 @@ func sweepAggregateAuthorizations(region string) error {
 	return nil
 }
 
-func sweepConfigurationAggregators(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigurationAggregators(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -121,8 +119,7 @@ func sweepConfigurationAggregators(region string) error {
 	return nil
 }
 
-func sweepConfigurationRecorder(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigurationRecorder(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -165,8 +162,7 @@ func sweepConfigurationRecorder(region string) error {
 	return nil
 }
 
-func sweepDeliveryChannels(region string) error {
-	ctx := sweep.Context(region)
+func sweepDeliveryChannels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
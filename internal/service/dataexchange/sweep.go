@@ -7,25 +7,24 @@
 package dataexchange
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dataexchange"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dataexchange_data_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dataexchange_data_set", &sweep.Sweeper{
 		Name: "aws_dataexchange_data_set",
 		F:    sweepDataSets,
 	})
 }
 
-func sweepDataSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepDataSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -15,13 +15,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_agent", &sweep.Sweeper{
 		Name: "aws_datasync_agent",
 		F:    sweepAgents,
 		Dependencies: []string{
@@ -30,7 +29,7 @@ func init() {
 	})
 
 	// Pseudo-resource for any DataSync location resource type.
-	resource.AddTestSweepers("aws_datasync_location", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location", &sweep.Sweeper{
 		Name: "aws_datasync_location",
 		F:    sweepLocations,
 		Dependencies: []string{
@@ -38,14 +37,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_task", &sweep.Sweeper{
 		Name: "aws_datasync_task",
 		F:    sweepTasks,
 	})
 }
 
-func sweepAgents(region string) error {
-	ctx := sweep.Context(region)
+func sweepAgents(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -88,8 +86,7 @@ func sweepAgents(region string) error {
 	return nil
 }
 
-func sweepLocations(region string) error {
-	ctx := sweep.Context(region)
+func sweepLocations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -155,8 +152,7 @@ func (sweepable *sweepableLocation) Delete(ctx context.Context, timeout time.Dur
 	return nil
 }
 
-func sweepTasks(region string) error {
-	ctx := sweep.Context(region)
+func sweepTasks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -7,24 +7,23 @@
 package dax
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dax_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dax_cluster", &sweep.Sweeper{
 		Name: "aws_dax_cluster",
 		F:    sweepClusters,
 	})
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
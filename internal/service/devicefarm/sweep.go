@@ -7,30 +7,29 @@
 package devicefarm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_devicefarm_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_devicefarm_project", &sweep.Sweeper{
 		Name: "aws_devicefarm_project",
 		F:    sweepProjects,
 	})
 
-	resource.AddTestSweepers("aws_devicefarm_test_grid_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_devicefarm_test_grid_project", &sweep.Sweeper{
 		Name: "aws_devicefarm_test_grid_project",
 		F:    sweepTestGridProjects,
 	})
 }
 
-func sweepProjects(region string) error {
-	ctx := sweep.Context(region)
+func sweepProjects(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -84,8 +83,7 @@ func sweepProjects(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepTestGridProjects(region string) error {
-	ctx := sweep.Context(region)
+func sweepTestGridProjects(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
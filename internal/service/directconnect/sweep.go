@@ -7,6 +7,7 @@
 package directconnect
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -15,22 +16,21 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_connection", &sweep.Sweeper{
 		Name: "aws_dx_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association_proposal", &sweep.Sweeper{
 		Name: "aws_dx_gateway_association_proposal",
 		F:    sweepGatewayAssociationProposals,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association", &sweep.Sweeper{
 		Name: "aws_dx_gateway_association",
 		F:    sweepGatewayAssociations,
 		Dependencies: []string{
@@ -38,7 +38,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway", &sweep.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -46,21 +46,20 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_lag", &sweep.Sweeper{
 		Name:         "aws_dx_lag",
 		F:            sweepLags,
 		Dependencies: []string{"aws_dx_connection"},
 	})
 
-	resource.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_macsec_key", &sweep.Sweeper{
 		Name:         "aws_dx_macsec_key",
 		F:            sweepMacSecKeys,
 		Dependencies: []string{},
 	})
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -114,8 +113,7 @@ func sweepConnections(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepGatewayAssociationProposals(region string) error {
-	ctx := sweep.Context(region)
+func sweepGatewayAssociationProposals(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -171,8 +169,7 @@ func sweepGatewayAssociationProposals(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepGatewayAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepGatewayAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -308,8 +305,7 @@ func sweepGatewayAssociations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -393,8 +389,7 @@ func sweepGateways(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLags(region string) error {
-	ctx := sweep.Context(region)
+func sweepLags(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -448,8 +443,7 @@ func sweepLags(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepMacSecKeys(region string) error {
-	ctx := sweep.Context(region)
+func sweepMacSecKeys(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -7,26 +7,25 @@
 package dlm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dlm"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dlm_lifecycle_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dlm_lifecycle_policy", &sweep.Sweeper{
 		Name: "aws_dlm_lifecycle_policy",
 		F:    sweepLifecyclePolicies,
 	})
 
 }
 
-func sweepLifecyclePolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepLifecyclePolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
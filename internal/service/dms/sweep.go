@@ -7,22 +7,22 @@
 package dms
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dms_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_endpoint", &sweep.Sweeper{
 		Name: "aws_dms_endpoint",
 		F:    sweepEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_instance", &sweep.Sweeper{
 		Name: "aws_dms_replication_instance",
 		F:    sweepReplicationInstances,
 		Dependencies: []string{
@@ -31,19 +31,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_subnet_group", &sweep.Sweeper{
 		Name: "aws_dms_replication_subnet_group",
 		F:    sweepReplicationSubnetGroups,
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_task", &sweep.Sweeper{
 		Name: "aws_dms_replication_task",
 		F:    sweepReplicationTasks,
 	})
 }
 
-func sweepEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -87,8 +86,7 @@ func sweepEndpoints(region string) error {
 	return nil
 }
 
-func sweepReplicationInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepReplicationInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -132,8 +130,7 @@ func sweepReplicationInstances(region string) error {
 	return nil
 }
 
-func sweepReplicationSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepReplicationSubnetGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -176,8 +173,7 @@ func sweepReplicationSubnetGroups(region string) error {
 	return nil
 }
 
-func sweepReplicationTasks(region string) error {
-	ctx := sweep.Context(region)
+func sweepReplicationTasks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
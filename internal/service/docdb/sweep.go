@@ -7,6 +7,7 @@
 package docdb
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,12 +15,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/docdb"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_docdb_global_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_global_cluster", &sweep.Sweeper{
 		Name: "aws_docdb_global_cluster",
 		F:    sweepGlobalClusters,
 		Dependencies: []string{
@@ -27,7 +27,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_subnet_group", &sweep.Sweeper{
 		Name: "aws_docdb_subnet_group",
 		F:    sweepDBSubnetGroups,
 		Dependencies: []string{
@@ -35,12 +35,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_event_subscription", &sweep.Sweeper{
 		Name: "aws_docdb_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster", &sweep.Sweeper{
 		Name: "aws_docdb_cluster",
 		F:    sweepDBClusters,
 		Dependencies: []string{
@@ -49,7 +49,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_snapshot", &sweep.Sweeper{
 		Name: "aws_docdb_cluster_snapshot",
 		F:    sweepDBClusterSnapshots,
 		Dependencies: []string{
@@ -57,12 +57,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_instance", &sweep.Sweeper{
 		Name: "aws_docdb_cluster_instance",
 		F:    sweepDBInstances,
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_parameter_group", &sweep.Sweeper{
 		Name: "aws_docdb_cluster_parameter_group",
 		F:    sweepDBClusterParameterGroups,
 		Dependencies: []string{
@@ -71,8 +71,7 @@ func init() {
 	})
 }
 
-func sweepDBClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepDBClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -118,8 +117,7 @@ func sweepDBClusters(region string) error {
 	return nil
 }
 
-func sweepDBClusterSnapshots(region string) error {
-	ctx := sweep.Context(region)
+func sweepDBClusterSnapshots(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -164,8 +162,7 @@ func sweepDBClusterSnapshots(region string) error {
 	return nil
 }
 
-func sweepDBClusterParameterGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepDBClusterParameterGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -211,8 +208,7 @@ func sweepDBClusterParameterGroups(region string) error {
 	return nil
 }
 
-func sweepDBInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepDBInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -256,8 +252,7 @@ func sweepDBInstances(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepGlobalClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepGlobalClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -302,8 +297,7 @@ func sweepGlobalClusters(region string) error {
 	return nil
 }
 
-func sweepDBSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepDBSubnetGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -348,8 +342,7 @@ func sweepDBSubnetGroups(region string) error {
 	return nil
 }
 
-func sweepEventSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepEventSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
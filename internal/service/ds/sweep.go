@@ -7,6 +7,7 @@
 package ds
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,12 +15,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_directory_service_directory", &sweep.Sweeper{
 		Name: "aws_directory_service_directory",
 		F:    sweepDirectories,
 		Dependencies: []string{
@@ -35,14 +35,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_directory_service_region", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_directory_service_region", &sweep.Sweeper{
 		Name: "aws_directory_service_region",
 		F:    sweepRegions,
 	})
 }
 
-func sweepDirectories(region string) error {
-	ctx := sweep.Context(region)
+func sweepDirectories(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -86,8 +85,7 @@ func sweepDirectories(region string) error {
 	return nil
 }
 
-func sweepRegions(region string) error {
-	ctx := sweep.Context(region)
+func sweepRegions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
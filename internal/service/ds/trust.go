@@ -44,6 +44,7 @@ const (
 )
 
 // @FrameworkResource
+// @NoSweeper("deleted with aws_directory_service_directory")
 func newResourceTrust(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceTrust{}, nil
 }
@@ -56,6 +56,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Table",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
+				AlwaysUpdate:        true,
 			},
 		},
 		{
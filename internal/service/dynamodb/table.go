@@ -38,7 +38,7 @@ const (
 )
 
 // @SDKResource("aws_dynamodb_table", name="Table")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", alwaysUpdate=true)
 func ResourceTable() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -1649,6 +1650,37 @@ func FindNetworkInterfaceByID(ctx context.Context, conn *ec2.EC2, id string) (*e
 	return output, nil
 }
 
+// findNetworkInterfaceByIDBatched looks up a network interface by ID.
+// If read batching is enabled then concurrent lookups are coalesced into a single DescribeNetworkInterfaces call.
+func findNetworkInterfaceByIDBatched(ctx context.Context, client *conns.AWSClient, id string) (*ec2.NetworkInterface, error) {
+	return findByIDBatched(ctx, client, "ec2.DescribeNetworkInterfaces", id, FindNetworkInterfaceByID, findNetworkInterfacesByIDs)
+}
+
+// findNetworkInterfacesByIDs returns the network interfaces with the specified IDs, keyed by ID.
+// A filter is used so that IDs of network interfaces that don't exist are omitted rather than failing the request.
+func findNetworkInterfacesByIDs(ctx context.Context, conn *ec2.EC2, ids []string) (map[string]*ec2.NetworkInterface, error) {
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{NewFilter("network-interface-id", ids)},
+	}
+
+	output, err := FindNetworkInterfaces(ctx, conn, input)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]*ec2.NetworkInterface, len(output))
+	for _, v := range output {
+		m[aws.StringValue(v.NetworkInterfaceId)] = v
+	}
+
+	return m, nil
+}
+
 func FindNetworkInterfacesByAttachmentInstanceOwnerIDAndDescription(ctx context.Context, conn *ec2.EC2, attachmentInstanceOwnerID, description string) ([]*ec2.NetworkInterface, error) {
 	input := &ec2.DescribeNetworkInterfacesInput{
 		Filters: BuildAttributeFilterList(map[string]string{
@@ -1953,6 +1985,37 @@ func FindRouteTableByID(ctx context.Context, conn *ec2.EC2, routeTableID string)
 	return FindRouteTable(ctx, conn, input)
 }
 
+// findRouteTableByIDBatched looks up a route table by ID.
+// If read batching is enabled then concurrent lookups are coalesced into a single DescribeRouteTables call.
+func findRouteTableByIDBatched(ctx context.Context, client *conns.AWSClient, id string) (*ec2.RouteTable, error) {
+	return findByIDBatched(ctx, client, "ec2.DescribeRouteTables", id, FindRouteTableByID, findRouteTablesByIDs)
+}
+
+// findRouteTablesByIDs returns the route tables with the specified IDs, keyed by ID.
+// A filter is used so that IDs of route tables that don't exist are omitted rather than failing the request.
+func findRouteTablesByIDs(ctx context.Context, conn *ec2.EC2, ids []string) (map[string]*ec2.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{NewFilter("route-table-id", ids)},
+	}
+
+	output, err := FindRouteTables(ctx, conn, input)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]*ec2.RouteTable, len(output))
+	for _, v := range output {
+		m[aws.StringValue(v.RouteTableId)] = v
+	}
+
+	return m, nil
+}
+
 func FindRouteTable(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeRouteTablesInput) (*ec2.RouteTable, error) {
 	output, err := FindRouteTables(ctx, conn, input)
 
@@ -2088,6 +2151,65 @@ func FindSecurityGroupByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.
 	return output, nil
 }
 
+// findByIDBatched looks up an EC2 resource by ID.
+// If read batching is enabled then concurrent lookups with the same key are coalesced into a single call to findByIDs,
+// which returns the resources with the specified IDs keyed by ID, omitting those that don't exist.
+// Otherwise findByID is called.
+func findByIDBatched[T any](ctx context.Context, client *conns.AWSClient, key, id string, findByID func(context.Context, *ec2.EC2, string) (T, error), findByIDs func(context.Context, *ec2.EC2, []string) (map[string]T, error)) (T, error) {
+	conn := client.EC2Conn(ctx)
+
+	if !client.ReadBatchingEnabled() {
+		return findByID(ctx, conn, id)
+	}
+
+	output, ok, err := conns.BatchRead(ctx, client, key, id, func(ctx context.Context, ids []string) (map[string]T, error) {
+		return findByIDs(ctx, conn, ids)
+	})
+
+	if err != nil {
+		return output, err
+	}
+
+	if !ok {
+		return output, &retry.NotFoundError{
+			LastError: fmt.Errorf("%s: %s not found", key, id),
+		}
+	}
+
+	return output, nil
+}
+
+// findSecurityGroupByIDBatched looks up a security group by ID.
+// If read batching is enabled then concurrent lookups are coalesced into a single DescribeSecurityGroups call.
+func findSecurityGroupByIDBatched(ctx context.Context, client *conns.AWSClient, id string) (*ec2.SecurityGroup, error) {
+	return findByIDBatched(ctx, client, "ec2.DescribeSecurityGroups", id, FindSecurityGroupByID, findSecurityGroupsByIDs)
+}
+
+// findSecurityGroupsByIDs returns the security groups with the specified IDs, keyed by ID.
+// A filter is used so that IDs of security groups that don't exist are omitted rather than failing the request.
+func findSecurityGroupsByIDs(ctx context.Context, conn *ec2.EC2, ids []string) (map[string]*ec2.SecurityGroup, error) {
+	input := &ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{NewFilter("group-id", ids)},
+	}
+
+	output, err := FindSecurityGroups(ctx, conn, input)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]*ec2.SecurityGroup, len(output))
+	for _, v := range output {
+		m[aws.StringValue(v.GroupId)] = v
+	}
+
+	return m, nil
+}
+
 // FindSecurityGroupByNameAndVPCID looks up a security group by name, VPC ID. Returns a retry.NotFoundError if not found.
 func FindSecurityGroupByNameAndVPCID(ctx context.Context, conn *ec2.EC2, name, vpcID string) (*ec2.SecurityGroup, error) {
 	input := &ec2.DescribeSecurityGroupsInput{
@@ -2239,6 +2361,37 @@ func FindSecurityGroupRuleByID(ctx context.Context, conn *ec2.EC2, id string) (*
 	return output, nil
 }
 
+// findSecurityGroupRuleByIDBatched looks up a security group rule by ID.
+// If read batching is enabled then concurrent lookups are coalesced into a single DescribeSecurityGroupRules call.
+func findSecurityGroupRuleByIDBatched(ctx context.Context, client *conns.AWSClient, id string) (*ec2.SecurityGroupRule, error) {
+	return findByIDBatched(ctx, client, "ec2.DescribeSecurityGroupRules", id, FindSecurityGroupRuleByID, findSecurityGroupRulesByIDs)
+}
+
+// findSecurityGroupRulesByIDs returns the security group rules with the specified IDs, keyed by ID.
+// A filter is used so that IDs of rules that don't exist are omitted rather than failing the request.
+func findSecurityGroupRulesByIDs(ctx context.Context, conn *ec2.EC2, ids []string) (map[string]*ec2.SecurityGroupRule, error) {
+	input := &ec2.DescribeSecurityGroupRulesInput{
+		Filters: []*ec2.Filter{NewFilter("security-group-rule-id", ids)},
+	}
+
+	output, err := FindSecurityGroupRules(ctx, conn, input)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]*ec2.SecurityGroupRule, len(output))
+	for _, v := range output {
+		m[aws.StringValue(v.SecurityGroupRuleId)] = v
+	}
+
+	return m, nil
+}
+
 func FindSecurityGroupEgressRuleByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.SecurityGroupRule, error) {
 	output, err := FindSecurityGroupRuleByID(ctx, conn, id)
 
@@ -2277,6 +2430,42 @@ func FindSecurityGroupRulesBySecurityGroupID(ctx context.Context, conn *ec2.EC2,
 	return FindSecurityGroupRules(ctx, conn, input)
 }
 
+// findSecurityGroupRulesBySecurityGroupIDBatched returns the rules of the specified security group.
+// If read batching is enabled then concurrent lookups are coalesced into a single DescribeSecurityGroupRules call.
+func findSecurityGroupRulesBySecurityGroupIDBatched(ctx context.Context, client *conns.AWSClient, id string) ([]*ec2.SecurityGroupRule, error) {
+	conn := client.EC2Conn(ctx)
+
+	if !client.ReadBatchingEnabled() {
+		return FindSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+	}
+
+	output, _, err := conns.BatchRead(ctx, client, "ec2.DescribeSecurityGroupRules:group-id", id, func(ctx context.Context, ids []string) (map[string][]*ec2.SecurityGroupRule, error) {
+		input := &ec2.DescribeSecurityGroupRulesInput{
+			Filters: []*ec2.Filter{NewFilter("group-id", ids)},
+		}
+
+		output, err := FindSecurityGroupRules(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		m := make(map[string][]*ec2.SecurityGroupRule)
+		for _, v := range output {
+			k := aws.StringValue(v.GroupId)
+			m[k] = append(m[k], v)
+		}
+
+		return m, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindSpotDatafeedSubscription(ctx context.Context, conn *ec2.EC2) (*ec2.SpotDatafeedSubscription, error) {
 	input := &ec2.DescribeSpotDatafeedSubscriptionInput{}
 
@@ -2537,6 +2726,37 @@ func FindSubnetByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Subnet,
 	return output, nil
 }
 
+// findSubnetByIDBatched looks up a subnet by ID.
+// If read batching is enabled then concurrent lookups are coalesced into a single DescribeSubnets call.
+func findSubnetByIDBatched(ctx context.Context, client *conns.AWSClient, id string) (*ec2.Subnet, error) {
+	return findByIDBatched(ctx, client, "ec2.DescribeSubnets", id, FindSubnetByID, findSubnetsByIDs)
+}
+
+// findSubnetsByIDs returns the subnets with the specified IDs, keyed by ID.
+// A filter is used so that IDs of subnets that don't exist are omitted rather than failing the request.
+func findSubnetsByIDs(ctx context.Context, conn *ec2.EC2, ids []string) (map[string]*ec2.Subnet, error) {
+	input := &ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{NewFilter("subnet-id", ids)},
+	}
+
+	output, err := FindSubnets(ctx, conn, input)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]*ec2.Subnet, len(output))
+	for _, v := range output {
+		m[aws.StringValue(v.SubnetId)] = v
+	}
+
+	return m, nil
+}
+
 func FindSubnet(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSubnetsInput) (*ec2.Subnet, error) {
 	output, err := FindSubnets(ctx, conn, input)
 
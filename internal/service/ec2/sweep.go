@@ -7,6 +7,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -17,13 +18,12 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_customer_gateway", &sweep.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
 		Dependencies: []string{
@@ -31,17 +31,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_capacity_reservation", &sweep.Sweeper{
 		Name: "aws_ec2_capacity_reservation",
 		F:    sweepCapacityReservations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_carrier_gateway", &sweep.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateways,
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_endpoint", &sweep.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    sweepClientVPNEndpoints,
 		Dependencies: []string{
@@ -49,17 +49,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_network_association", &sweep.Sweeper{
 		Name: "aws_ec2_client_vpn_network_association",
 		F:    sweepClientVPNNetworkAssociations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_fleet", &sweep.Sweeper{
 		Name: "aws_ec2_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_volume", &sweep.Sweeper{
 		Name: "aws_ebs_volume",
 		Dependencies: []string{
 			"aws_instance",
@@ -67,7 +67,7 @@ func init() {
 		F: sweepEBSVolumes,
 	})
 
-	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_snapshot", &sweep.Sweeper{
 		Name: "aws_ebs_snapshot",
 		F:    sweepEBSSnapshots,
 		Dependencies: []string{
@@ -75,12 +75,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_egress_only_internet_gateway", &sweep.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    sweepEgressOnlyInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip", &sweep.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_vpc",
@@ -88,12 +88,12 @@ func init() {
 		F: sweepEIPs,
 	})
 
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_flow_log", &sweep.Sweeper{
 		Name: "aws_flow_log",
 		F:    sweepFlowLogs,
 	})
 
-	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_host", &sweep.Sweeper{
 		Name: "aws_ec2_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -101,7 +101,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_instance", &sweep.Sweeper{
 		Name: "aws_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -111,7 +111,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_internet_gateway", &sweep.Sweeper{
 		Name: "aws_internet_gateway",
 		Dependencies: []string{
 			"aws_subnet",
@@ -119,7 +119,7 @@ func init() {
 		F: sweepInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_key_pair", &sweep.Sweeper{
 		Name: "aws_key_pair",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
@@ -130,7 +130,7 @@ func init() {
 		F: sweepKeyPairs,
 	})
 
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_launch_template", &sweep.Sweeper{
 		Name: "aws_launch_template",
 		Dependencies: []string{
 			"aws_autoscaling_group",
@@ -139,17 +139,17 @@ func init() {
 		F: sweepLaunchTemplates,
 	})
 
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_nat_gateway", &sweep.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    sweepNATGateways,
 	})
 
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_acl", &sweep.Sweeper{
 		Name: "aws_network_acl",
 		F:    sweepNetworkACLs,
 	})
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_interface", &sweep.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -168,12 +168,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_network_insights_path", &sweep.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
 	})
 
-	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_placement_group", &sweep.Sweeper{
 		Name: "aws_placement_group",
 		F:    sweepPlacementGroups,
 		Dependencies: []string{
@@ -185,12 +185,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route_table", &sweep.Sweeper{
 		Name: "aws_route_table",
 		F:    sweepRouteTables,
 	})
 
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_security_group", &sweep.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
@@ -198,17 +198,17 @@ func init() {
 		F: sweepSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_fleet_request", &sweep.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    sweepSpotFleetRequests,
 	})
 
-	resource.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_instance_request", &sweep.Sweeper{
 		Name: "aws_spot_instance_request",
 		F:    sweepSpotInstanceRequests,
 	})
 
-	resource.AddTestSweepers("aws_subnet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_subnet", &sweep.Sweeper{
 		Name: "aws_subnet",
 		F:    sweepSubnets,
 		Dependencies: []string{
@@ -260,7 +260,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_filter", &sweep.Sweeper{
 		Name: "aws_ec2_traffic_mirror_filter",
 		F:    sweepTrafficMirrorFilters,
 		Dependencies: []string{
@@ -268,12 +268,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_session", &sweep.Sweeper{
 		Name: "aws_ec2_traffic_mirror_session",
 		F:    sweepTrafficMirrorSessions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_target", &sweep.Sweeper{
 		Name: "aws_ec2_traffic_mirror_target",
 		F:    sweepTrafficMirrorTargets,
 		Dependencies: []string{
@@ -281,17 +281,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_multicast_domain",
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
 		Dependencies: []string{
@@ -302,12 +302,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect_peer",
 		F:    sweepTransitGatewayConnectPeers,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect",
 		F:    sweepTransitGatewayConnects,
 		Dependencies: []string{
@@ -315,7 +315,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
 		Dependencies: []string{
@@ -324,12 +324,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_dhcp_options", &sweep.Sweeper{
 		Name: "aws_vpc_dhcp_options",
 		F:    sweepVPCDHCPOptions,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_service", &sweep.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    sweepVPCEndpointServices,
 		Dependencies: []string{
@@ -337,7 +337,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint", &sweep.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 		Dependencies: []string{
@@ -346,12 +346,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_peering_connection", &sweep.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc", &sweep.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
@@ -368,12 +368,12 @@ func init() {
 		F: sweepVPCs,
 	})
 
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_connection", &sweep.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    sweepVPNConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_gateway", &sweep.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    sweepVPNGateways,
 		Dependencies: []string{
@@ -382,34 +382,33 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_ipam", &sweep.Sweeper{
 		Name: "aws_vpc_ipam",
 		F:    sweepIPAMs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam_resource_discovery", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_ipam_resource_discovery", &sweep.Sweeper{
 		Name: "aws_vpc_ipam_resource_discovery",
 		F:    sweepIPAMResourceDiscoveries,
 	})
 
-	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ami", &sweep.Sweeper{
 		Name: "aws_ami",
 		F:    sweepAMIs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &sweep.Sweeper{
 		Name: "aws_vpc_network_performance_metric_subscription",
 		F:    sweepNetworkPerformanceMetricSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_instance_connect_endpoint", &sweep.Sweeper{
 		Name: "aws_ec2_instance_connect_endpoint",
 		F:    sweepInstanceConnectEndpoints,
 	})
 }
 
-func sweepCapacityReservations(region string) error {
-	ctx := sweep.Context(region)
+func sweepCapacityReservations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -453,8 +452,7 @@ func sweepCapacityReservations(region string) error {
 	return nil
 }
 
-func sweepCarrierGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepCarrierGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -497,8 +495,7 @@ func sweepCarrierGateways(region string) error {
 	return nil
 }
 
-func sweepClientVPNEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepClientVPNEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -541,8 +538,7 @@ func sweepClientVPNEndpoints(region string) error {
 	return nil
 }
 
-func sweepClientVPNNetworkAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepClientVPNNetworkAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -609,8 +605,7 @@ func sweepClientVPNNetworkAssociations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
+func sweepFleets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -656,8 +651,7 @@ func sweepFleets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepEBSVolumes(region string) error {
-	ctx := sweep.Context(region)
+func sweepEBSVolumes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -707,8 +701,7 @@ func sweepEBSVolumes(region string) error {
 	return nil
 }
 
-func sweepEBSSnapshots(region string) error {
-	ctx := sweep.Context(region)
+func sweepEBSSnapshots(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -753,8 +746,7 @@ func sweepEBSSnapshots(region string) error {
 	return nil
 }
 
-func sweepEgressOnlyInternetGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepEgressOnlyInternetGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -797,8 +789,7 @@ func sweepEgressOnlyInternetGateways(region string) error {
 	return nil
 }
 
-func sweepEIPs(region string) error {
-	ctx := sweep.Context(region)
+func sweepEIPs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -860,8 +851,7 @@ func sweepEIPs(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepFlowLogs(region string) error {
-	ctx := sweep.Context(region)
+func sweepFlowLogs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -904,8 +894,7 @@ func sweepFlowLogs(region string) error {
 	return nil
 }
 
-func sweepHosts(region string) error {
-	ctx := sweep.Context(region)
+func sweepHosts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -948,8 +937,7 @@ func sweepHosts(region string) error {
 	return nil
 }
 
-func sweepInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -977,6 +965,10 @@ func sweepInstances(region string) error {
 					continue
 				}
 
+				if !sweep.AllowResource(ctx, KeyValueTags(ctx, instance.Tags).Map(), aws.TimeValue(instance.LaunchTime)) {
+					continue
+				}
+
 				r := ResourceInstance()
 				d := r.Data(nil)
 				d.SetId(id)
@@ -1004,8 +996,7 @@ func sweepInstances(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepInternetGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepInternetGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1087,8 +1078,7 @@ func sweepInternetGateways(region string) error {
 	return nil
 }
 
-func sweepKeyPairs(region string) error {
-	ctx := sweep.Context(region)
+func sweepKeyPairs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1125,8 +1115,7 @@ func sweepKeyPairs(region string) error {
 	return nil
 }
 
-func sweepLaunchTemplates(region string) error {
-	ctx := sweep.Context(region)
+func sweepLaunchTemplates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1169,8 +1158,7 @@ func sweepLaunchTemplates(region string) error {
 	return nil
 }
 
-func sweepNATGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepNATGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1213,8 +1201,7 @@ func sweepNATGateways(region string) error {
 	return nil
 }
 
-func sweepNetworkACLs(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkACLs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1269,8 +1256,7 @@ func sweepNetworkACLs(region string) error {
 	return nil
 }
 
-func sweepNetworkInterfaces(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkInterfaces(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1320,8 +1306,7 @@ func sweepNetworkInterfaces(region string) error {
 	return nil
 }
 
-func sweepNetworkInsightsPaths(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkInsightsPaths(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1360,8 +1345,7 @@ func sweepNetworkInsightsPaths(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepPlacementGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepPlacementGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1398,8 +1382,7 @@ func sweepPlacementGroups(region string) error {
 	return nil
 }
 
-func sweepRouteTables(region string) error {
-	ctx := sweep.Context(region)
+func sweepRouteTables(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1518,8 +1501,7 @@ func sweepRouteTables(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSecurityGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepSecurityGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -1610,8 +1592,7 @@ func sweepSecurityGroups(region string) error {
 	return nil
 }
 
-func sweepSpotFleetRequests(region string) error {
-	ctx := sweep.Context(region)
+func sweepSpotFleetRequests(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1662,8 +1643,7 @@ func sweepSpotFleetRequests(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSpotInstanceRequests(region string) error {
-	ctx := sweep.Context(region)
+func sweepSpotInstanceRequests(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1714,8 +1694,7 @@ func sweepSpotInstanceRequests(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSubnets(region string) error {
-	ctx := sweep.Context(region)
+func sweepSubnets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -1763,8 +1742,7 @@ func sweepSubnets(region string) error {
 	return nil
 }
 
-func sweepTrafficMirrorFilters(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficMirrorFilters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1807,8 +1785,7 @@ func sweepTrafficMirrorFilters(region string) error {
 	return nil
 }
 
-func sweepTrafficMirrorSessions(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficMirrorSessions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1851,8 +1828,7 @@ func sweepTrafficMirrorSessions(region string) error {
 	return nil
 }
 
-func sweepTrafficMirrorTargets(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficMirrorTargets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1895,8 +1871,7 @@ func sweepTrafficMirrorTargets(region string) error {
 	return nil
 }
 
-func sweepTransitGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1943,8 +1918,7 @@ func sweepTransitGateways(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayConnectPeers(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayConnectPeers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1991,8 +1965,7 @@ func sweepTransitGatewayConnectPeers(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayConnects(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayConnects(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2039,8 +2012,7 @@ func sweepTransitGatewayConnects(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayMulticastDomains(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayMulticastDomains(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -2087,8 +2059,7 @@ func sweepTransitGatewayMulticastDomains(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayPeeringAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayPeeringAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2135,8 +2106,7 @@ func sweepTransitGatewayPeeringAttachments(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayVPCAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayVPCAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2183,8 +2153,7 @@ func sweepTransitGatewayVPCAttachments(region string) error {
 	return nil
 }
 
-func sweepVPCDHCPOptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCDHCPOptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2254,8 +2223,7 @@ func sweepVPCDHCPOptions(region string) error {
 	return nil
 }
 
-func sweepVPCEndpointServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpointServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -2302,8 +2270,7 @@ func sweepVPCEndpointServices(region string) error {
 	return nil
 }
 
-func sweepVPCEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -2350,8 +2317,7 @@ func sweepVPCEndpoints(region string) error {
 	return nil
 }
 
-func sweepVPCPeeringConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCPeeringConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2394,8 +2360,7 @@ func sweepVPCPeeringConnections(region string) error {
 	return nil
 }
 
-func sweepVPCs(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2415,6 +2380,11 @@ func sweepVPCs(region string) error {
 				continue
 			}
 
+			// VPCs have no creation time, so none are swept when a minimum age is set.
+			if !sweep.AllowResourceWithoutCreationTime(ctx, KeyValueTags(ctx, v.Tags).Map()) {
+				continue
+			}
+
 			r := ResourceVPC()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.VpcId))
@@ -2443,8 +2413,7 @@ func sweepVPCs(region string) error {
 	return nil
 }
 
-func sweepVPNConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPNConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2485,8 +2454,7 @@ func sweepVPNConnections(region string) error {
 	return nil
 }
 
-func sweepVPNGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPNGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2535,8 +2503,7 @@ func sweepVPNGateways(region string) error {
 	return nil
 }
 
-func sweepCustomerGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomerGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2577,8 +2544,7 @@ func sweepCustomerGateways(region string) error {
 	return nil
 }
 
-func sweepIPAMs(region string) error {
-	ctx := sweep.Context(region)
+func sweepIPAMs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2622,8 +2588,7 @@ func sweepIPAMs(region string) error {
 	return nil
 }
 
-func sweepIPAMResourceDiscoveries(region string) error {
-	ctx := sweep.Context(region)
+func sweepIPAMResourceDiscoveries(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2669,8 +2634,7 @@ func sweepIPAMResourceDiscoveries(region string) error {
 	return nil
 }
 
-func sweepAMIs(region string) error {
-	ctx := sweep.Context(region)
+func sweepAMIs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2715,8 +2679,7 @@ func sweepAMIs(region string) error {
 	return nil
 }
 
-func sweepNetworkPerformanceMetricSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkPerformanceMetricSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2760,8 +2723,7 @@ func sweepNetworkPerformanceMetricSubscriptions(region string) error {
 	return nil
 }
 
-func sweepInstanceConnectEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepInstanceConnectEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/servicepackage/main.go; DO NOT EDIT.
+
+//go:build sweep
+// +build sweep
+
+package ec2
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	framework.Exclude(newResourceSecurityGroupEgressRule, "deleted with aws_security_group")
+	framework.Exclude(newResourceSecurityGroupIngressRule, "deleted with aws_security_group")
+}
@@ -260,9 +260,7 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
-
-	sg, err := FindSecurityGroupByID(ctx, conn, d.Id())
+	sg, err := findSecurityGroupByIDBatched(ctx, meta.(*conns.AWSClient), d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Group (%s) not found, removing from state", d.Id())
@@ -9,11 +9,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 )
 
 // @FrameworkResource(name="Security Group Egress Rule")
 // @Tags(identifierAttribute="id")
+// @NoSweeper("deleted with aws_security_group")
 func newResourceSecurityGroupEgressRule(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceSecurityGroupEgressRule{}
 	r.create = r.createSecurityGroupRule
@@ -60,7 +62,15 @@ func (r *resourceSecurityGroupEgressRule) deleteSecurityGroupRule(ctx context.Co
 }
 
 func (r *resourceSecurityGroupEgressRule) findSecurityGroupRuleByID(ctx context.Context, id string) (*ec2.SecurityGroupRule, error) {
-	conn := r.Meta().EC2Conn(ctx)
+	output, err := findSecurityGroupRuleByIDBatched(ctx, r.Meta(), id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !aws.BoolValue(output.IsEgress) {
+		return nil, &retry.NotFoundError{}
+	}
 
-	return FindSecurityGroupEgressRuleByID(ctx, conn, id)
+	return output, nil
 }
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// listSecurityGroups lists existing security groups.
+// Filters are passed through to the DescribeSecurityGroups API, e.g. `vpc-id`, `group-name` or `tag:Name`.
+//
+// @ListResource("aws_security_group", name="Security Group")
+func listSecurityGroups(ctx context.Context, meta any, filters map[string]string) ([]types.ListResult, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.DescribeSecurityGroupsInput{
+		Filters: BuildAttributeFilterList(filters),
+	}
+
+	groups, err := FindSecurityGroups(ctx, conn, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("listing Security Groups: %w", err)
+	}
+
+	var results []types.ListResult
+
+	for _, group := range groups {
+		results = append(results, types.ListResult{
+			DisplayName: aws.StringValue(group.GroupName),
+			ImportID:    aws.StringValue(group.GroupId),
+		})
+	}
+
+	return results, nil
+}
@@ -250,11 +250,10 @@ information and instructions for recovery. Error: %s`, securityGroupID, err)
 }
 
 func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 	securityGroupID := d.Get("security_group_id").(string)
 	ruleType := d.Get("type").(string)
 
-	sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
+	sg, err := findSecurityGroupByIDBatched(ctx, meta.(*conns.AWSClient), securityGroupID)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Group (%s) not found, removing from state", securityGroupID)
@@ -300,7 +299,7 @@ func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// Attempt to find the single matching AWS Security Group Rule resource ID.
-	securityGroupRules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+	securityGroupRules, err := findSecurityGroupRulesBySecurityGroupIDBatched(ctx, meta.(*conns.AWSClient), securityGroupID)
 
 	if err != nil {
 		return diag.Errorf("reading Security Group (%s) Rules: %s", securityGroupID, err)
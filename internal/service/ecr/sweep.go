@@ -7,6 +7,7 @@
 package ecr
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,19 +15,17 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecr_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecr_repository", &sweep.Sweeper{
 		Name: "aws_ecr_repository",
 		F:    sweepRepositories,
 	})
 }
 
-func sweepRepositories(region string) error {
-	ctx := sweep.Context(region)
+func sweepRepositories(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
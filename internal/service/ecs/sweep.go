@@ -7,18 +7,18 @@
 package ecs
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_capacity_provider", &sweep.Sweeper{
 		Name: "aws_ecs_capacity_provider",
 		F:    sweepCapacityProviders,
 		Dependencies: []string{
@@ -27,7 +27,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_cluster", &sweep.Sweeper{
 		Name: "aws_ecs_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -35,12 +35,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_service", &sweep.Sweeper{
 		Name: "aws_ecs_service",
 		F:    sweepServices,
 	})
 
-	resource.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_task_definition", &sweep.Sweeper{
 		Name: "aws_ecs_task_definition",
 		F:    sweepTaskDefinitions,
 		Dependencies: []string{
@@ -49,8 +49,7 @@ func init() {
 	})
 }
 
-func sweepCapacityProviders(region string) error {
-	ctx := sweep.Context(region)
+func sweepCapacityProviders(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -100,8 +99,7 @@ func sweepCapacityProviders(region string) error {
 	return nil
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -144,8 +142,7 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -213,8 +210,7 @@ func sweepServices(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepTaskDefinitions(region string) error {
-	ctx := sweep.Context(region)
+func sweepTaskDefinitions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
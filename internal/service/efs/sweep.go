@@ -7,23 +7,23 @@
 package efs
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/efs"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_efs_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_access_point", &sweep.Sweeper{
 		Name: "aws_efs_access_point",
 		F:    sweepAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_efs_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_file_system", &sweep.Sweeper{
 		Name: "aws_efs_file_system",
 		F:    sweepFileSystems,
 		Dependencies: []string{
@@ -32,14 +32,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_efs_mount_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_mount_target", &sweep.Sweeper{
 		Name: "aws_efs_mount_target",
 		F:    sweepMountTargets,
 	})
 }
 
-func sweepAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccessPoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -105,8 +104,7 @@ func sweepAccessPoints(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepFileSystems(region string) error {
-	ctx := sweep.Context(region)
+func sweepFileSystems(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -149,8 +147,7 @@ func sweepFileSystems(region string) error {
 	return nil
 }
 
-func sweepMountTargets(region string) error {
-	ctx := sweep.Context(region)
+func sweepMountTargets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -7,6 +7,7 @@
 package eks
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,17 +15,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_eks_addon", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_addon", &sweep.Sweeper{
 		Name: "aws_eks_addon",
 		F:    sweepAddons,
 	})
 
-	resource.AddTestSweepers("aws_eks_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_cluster", &sweep.Sweeper{
 		Name: "aws_eks_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -35,24 +35,23 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_eks_fargate_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_fargate_profile", &sweep.Sweeper{
 		Name: "aws_eks_fargate_profile",
 		F:    sweepFargateProfiles,
 	})
 
-	resource.AddTestSweepers("aws_eks_identity_provider_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_identity_provider_config", &sweep.Sweeper{
 		Name: "aws_eks_identity_provider_config",
 		F:    sweepIdentityProvidersConfig,
 	})
 
-	resource.AddTestSweepers("aws_eks_node_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_node_group", &sweep.Sweeper{
 		Name: "aws_eks_node_group",
 		F:    sweepNodeGroups,
 	})
 }
 
-func sweepAddons(region string) error {
-	ctx := sweep.Context(region)
+func sweepAddons(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -126,8 +125,7 @@ func sweepAddons(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -170,8 +168,7 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepFargateProfiles(region string) error {
-	ctx := sweep.Context(region)
+func sweepFargateProfiles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -243,8 +240,7 @@ func sweepFargateProfiles(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepIdentityProvidersConfig(region string) error {
-	ctx := sweep.Context(region)
+func sweepIdentityProvidersConfig(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -317,8 +313,7 @@ func sweepIdentityProvidersConfig(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepNodeGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepNodeGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
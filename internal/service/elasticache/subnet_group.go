@@ -75,7 +75,7 @@ func resourceSubnetGroupDiff(ctx context.Context, diff *schema.ResourceDiff, met
 	// Reserved ElastiCache Subnet Groups with the name "default" do not support tagging;
 	// thus we must suppress the diff originating from the provider-level default_tags configuration
 	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19213
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := tftags.DefaultConfigFromContext(ctx, meta.(*conns.AWSClient).DefaultTagsConfig)
 	if len(defaultTagsConfig.GetTags()) > 0 && diff.Get("name").(string) == "default" {
 		return nil
 	}
@@ -7,6 +7,7 @@
 package elasticbeanstalk
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,25 +15,23 @@ import (
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elastic_beanstalk_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elastic_beanstalk_application", &sweep.Sweeper{
 		Name:         "aws_elastic_beanstalk_application",
 		Dependencies: []string{"aws_elastic_beanstalk_environment"},
 		F:            sweepApplications,
 	})
 
-	resource.AddTestSweepers("aws_elastic_beanstalk_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elastic_beanstalk_environment", &sweep.Sweeper{
 		Name: "aws_elastic_beanstalk_environment",
 		F:    sweepEnvironments,
 	})
 }
 
-func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
+func sweepApplications(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -72,8 +71,7 @@ func sweepApplications(region string) error {
 	return errors
 }
 
-func sweepEnvironments(region string) error {
-	ctx := sweep.Context(region)
+func sweepEnvironments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -7,24 +7,23 @@
 package elb
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elb"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elb", &sweep.Sweeper{
 		Name: "aws_elb",
 		F:    sweepLoadBalancers,
 	})
 }
 
-func sweepLoadBalancers(region string) error {
-	ctx := sweep.Context(region)
+func sweepLoadBalancers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,18 +7,18 @@
 package elbv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb", &sweep.Sweeper{
 		Name: "aws_lb",
 		F:    sweepLoadBalancers,
 		Dependencies: []string{
@@ -28,7 +28,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb_target_group", &sweep.Sweeper{
 		Name: "aws_lb_target_group",
 		F:    sweepTargetGroups,
 		Dependencies: []string{
@@ -36,14 +36,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb_listener", &sweep.Sweeper{
 		Name: "aws_lb_listener",
 		F:    sweepListeners,
 	})
 }
 
-func sweepLoadBalancers(region string) error {
-	ctx := sweep.Context(region)
+func sweepLoadBalancers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -82,8 +81,7 @@ func sweepLoadBalancers(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepTargetGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepTargetGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -119,8 +117,7 @@ func sweepTargetGroups(region string) error {
 	return nil
 }
 
-func sweepListeners(region string) error {
-	ctx := sweep.Context(region)
+func sweepListeners(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
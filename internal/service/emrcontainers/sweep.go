@@ -7,29 +7,28 @@
 package emrcontainers
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emrcontainers"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrcontainers_virtual_cluster", &sweep.Sweeper{
 		Name: "aws_emrcontainers_virtual_cluster",
 		F:    sweepVirtualClusters,
 	})
 
-	resource.AddTestSweepers("aws_emrcontainers_job_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrcontainers_job_template", &sweep.Sweeper{
 		Name: "aws_emrcontainers_job_template",
 		F:    sweepJobTemplates,
 	})
 }
 
-func sweepVirtualClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepVirtualClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -76,8 +75,7 @@ func sweepVirtualClusters(region string) error {
 	return nil
 }
 
-func sweepJobTemplates(region string) error {
-	ctx := sweep.Context(region)
+func sweepJobTemplates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,25 +7,24 @@
 package emrserverless
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
 	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emrserverless_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrserverless_application", &sweep.Sweeper{
 		Name: "aws_emrserverless_application",
 		F:    sweepApplications,
 	})
 }
 
-func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
+func sweepApplications(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,6 +7,7 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -14,12 +15,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_api_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_api_destination", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_api_destination",
 		F:    sweepAPIDestination,
 		Dependencies: []string{
@@ -27,7 +27,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_archive", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_archive",
 		F:    sweepArchives,
 		Dependencies: []string{
@@ -35,7 +35,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_bus", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_bus",
 		F:    sweepBuses,
 		Dependencies: []string{
@@ -45,17 +45,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_connection", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_connection",
 		F:    sweepConnection,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_permission", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_permission",
 		F:    sweepPermissions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_rule", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -63,14 +63,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_target", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_target",
 		F:    sweepTargets,
 	})
 }
 
-func sweepAPIDestination(region string) error {
-	ctx := sweep.Context(region)
+func sweepAPIDestination(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -120,8 +119,7 @@ func sweepAPIDestination(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepArchives(region string) error {
-	ctx := sweep.Context(region)
+func sweepArchives(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -171,8 +169,7 @@ func sweepArchives(region string) error {
 	return nil
 }
 
-func sweepBuses(region string) error {
-	ctx := sweep.Context(region)
+func sweepBuses(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -218,8 +215,7 @@ func sweepBuses(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepConnection(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnection(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -266,8 +262,7 @@ func sweepConnection(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPermissions(region string) error {
-	ctx := sweep.Context(region)
+func sweepPermissions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -311,8 +306,7 @@ func sweepPermissions(region string) error {
 	return nil
 }
 
-func sweepRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -381,8 +375,7 @@ func sweepRules(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepTargets(region string) error {
-	ctx := sweep.Context(region)
+func sweepTargets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
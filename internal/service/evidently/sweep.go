@@ -7,25 +7,24 @@
 package evidently
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_evidently_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_evidently_project", &sweep.Sweeper{
 		Name: "aws_evidently_project",
 		F:    sweepProject,
 	})
 }
 
-func sweepProject(region string) error {
-	ctx := sweep.Context(region)
+func sweepProject(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
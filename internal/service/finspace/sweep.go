@@ -7,26 +7,25 @@
 package finspace
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/finspace"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_finspace_kx_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_finspace_kx_environment", &sweep.Sweeper{
 		Name: "aws_finspace_kx_environment",
 		F:    sweepKxEnvironments,
 	})
 }
 
-func sweepKxEnvironments(region string) error {
-	ctx := sweep.Context(region)
+func sweepKxEnvironments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
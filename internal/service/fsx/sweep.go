@@ -7,23 +7,23 @@
 package fsx
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_fsx_backup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_backup", &sweep.Sweeper{
 		Name: "aws_fsx_backup",
 		F:    sweepBackups,
 	})
 
-	resource.AddTestSweepers("aws_fsx_lustre_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_lustre_file_system", &sweep.Sweeper{
 		Name: "aws_fsx_lustre_file_system",
 		F:    sweepLustreFileSystems,
 		Dependencies: []string{
@@ -31,7 +31,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_ontap_file_system", &sweep.Sweeper{
 		Name: "aws_fsx_ontap_file_system",
 		F:    sweepOntapFileSystems,
 		Dependencies: []string{
@@ -40,7 +40,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_storage_virtual_machine", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_ontap_storage_virtual_machine", &sweep.Sweeper{
 		Name: "aws_fsx_ontap_storage_virtual_machine",
 		F:    sweepOntapStorageVirtualMachine,
 		Dependencies: []string{
@@ -48,12 +48,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_ontap_volume", &sweep.Sweeper{
 		Name: "aws_fsx_ontap_volume",
 		F:    sweepOntapVolume,
 	})
 
-	resource.AddTestSweepers("aws_fsx_openzfs_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_openzfs_file_system", &sweep.Sweeper{
 		Name: "aws_fsx_openzfs_file_system",
 		F:    sweepOpenZFSFileSystems,
 		Dependencies: []string{
@@ -62,12 +62,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_fsx_openzfs_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_openzfs_volume", &sweep.Sweeper{
 		Name: "aws_fsx_openzfs_volume",
 		F:    sweepOpenZFSVolume,
 	})
 
-	resource.AddTestSweepers("aws_fsx_windows_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_windows_file_system", &sweep.Sweeper{
 		Name: "aws_fsx_windows_file_system",
 		F:    sweepWindowsFileSystems,
 		Dependencies: []string{
@@ -77,8 +77,7 @@ func init() {
 	})
 }
 
-func sweepBackups(region string) error {
-	ctx := sweep.Context(region)
+func sweepBackups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -122,8 +121,7 @@ func sweepBackups(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepLustreFileSystems(region string) error {
-	ctx := sweep.Context(region)
+func sweepLustreFileSystems(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -171,8 +169,7 @@ func sweepLustreFileSystems(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepOntapFileSystems(region string) error {
-	ctx := sweep.Context(region)
+func sweepOntapFileSystems(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -220,8 +217,7 @@ func sweepOntapFileSystems(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepOntapStorageVirtualMachine(region string) error {
-	ctx := sweep.Context(region)
+func sweepOntapStorageVirtualMachine(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -265,8 +261,7 @@ func sweepOntapStorageVirtualMachine(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepOntapVolume(region string) error {
-	ctx := sweep.Context(region)
+func sweepOntapVolume(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -318,8 +313,7 @@ func sweepOntapVolume(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepOpenZFSFileSystems(region string) error {
-	ctx := sweep.Context(region)
+func sweepOpenZFSFileSystems(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -367,8 +361,7 @@ func sweepOpenZFSFileSystems(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepOpenZFSVolume(region string) error {
-	ctx := sweep.Context(region)
+func sweepOpenZFSVolume(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -419,8 +412,7 @@ func sweepOpenZFSVolume(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepWindowsFileSystems(region string) error {
-	ctx := sweep.Context(region)
+func sweepWindowsFileSystems(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -14,12 +14,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_alias", &sweep.Sweeper{
 		Name: "aws_gamelift_alias",
 		Dependencies: []string{
 			"aws_gamelift_fleet",
@@ -27,17 +26,17 @@ func init() {
 		F: sweepAliases,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_build", &sweep.Sweeper{
 		Name: "aws_gamelift_build",
 		F:    sweepBuilds,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_script", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_script", &sweep.Sweeper{
 		Name: "aws_gamelift_script",
 		F:    sweepScripts,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_fleet", &sweep.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
 			"aws_gamelift_build",
@@ -45,19 +44,18 @@ func init() {
 		F: sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_server_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_game_server_group", &sweep.Sweeper{
 		Name: "aws_gamelift_game_server_group",
 		F:    sweepGameServerGroups,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_game_session_queue", &sweep.Sweeper{
 		Name: "aws_gamelift_game_session_queue",
 		F:    sweepGameSessionQueue,
 	})
 }
 
-func sweepAliases(region string) error {
-	ctx := sweep.Context(region)
+func sweepAliases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -95,8 +93,7 @@ func sweepAliases(region string) error {
 	return nil
 }
 
-func sweepBuilds(region string) error {
-	ctx := sweep.Context(region)
+func sweepBuilds(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -133,8 +130,7 @@ func sweepBuilds(region string) error {
 	return nil
 }
 
-func sweepScripts(region string) error {
-	ctx := sweep.Context(region)
+func sweepScripts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -171,8 +167,7 @@ func sweepScripts(region string) error {
 	return nil
 }
 
-func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
+func sweepFleets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -226,8 +221,7 @@ func sweepFleets(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepGameServerGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepGameServerGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -281,8 +275,7 @@ func sweepGameServerGroups(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepGameSessionQueue(region string) error {
-	ctx := sweep.Context(region)
+func sweepGameSessionQueue(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -7,25 +7,24 @@
 package glacier
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/glacier"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glacier_vault", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glacier_vault", &sweep.Sweeper{
 		Name: "aws_glacier_vault",
 		F:    sweepVaults,
 	})
 }
 
-func sweepVaults(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaults(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
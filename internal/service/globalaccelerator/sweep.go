@@ -7,18 +7,18 @@
 package globalaccelerator
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_accelerator", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_accelerator",
 		F:    sweepAccelerators,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_listener", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_listener",
 		F:    sweepListeners,
 		Dependencies: []string{
@@ -34,12 +34,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_endpoint_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_endpoint_group", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_endpoint_group",
 		F:    sweepEndpointGroups,
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_accelerator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_custom_routing_accelerator", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_accelerator",
 		F:    sweepCustomRoutingAccelerators,
 		Dependencies: []string{
@@ -47,7 +47,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_custom_routing_listener", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_listener",
 		F:    sweepCustomRoutingListeners,
 		Dependencies: []string{
@@ -55,14 +55,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_endpoint_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_custom_routing_endpoint_group", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_endpoint_group",
 		F:    sweepCustomRoutingEndpointGroups,
 	})
 }
 
-func sweepAccelerators(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccelerators(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -105,8 +104,7 @@ func sweepAccelerators(region string) error {
 	return nil
 }
 
-func sweepEndpointGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepEndpointGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -194,8 +192,7 @@ func sweepEndpointGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepListeners(region string) error {
-	ctx := sweep.Context(region)
+func sweepListeners(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -261,8 +258,7 @@ func sweepListeners(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepCustomRoutingAccelerators(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomRoutingAccelerators(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -305,8 +301,7 @@ func sweepCustomRoutingAccelerators(region string) error {
 	return nil
 }
 
-func sweepCustomRoutingEndpointGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomRoutingEndpointGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -394,8 +389,7 @@ func sweepCustomRoutingEndpointGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepCustomRoutingListeners(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomRoutingListeners(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
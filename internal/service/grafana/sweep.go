@@ -7,25 +7,24 @@
 package grafana
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_grafana_workspace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_grafana_workspace", &sweep.Sweeper{
 		Name: "aws_grafana_workspace",
 		F:    sweepWorkSpaces,
 	})
 }
 
-func sweepWorkSpaces(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkSpaces(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -5,6 +5,8 @@ package iam
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
@@ -114,6 +116,50 @@ func FindUsers(ctx context.Context, conn *iam.IAM, nameRegex, pathPrefix string)
 	return results, err
 }
 
+func FindRoles(ctx context.Context, conn *iam.IAM, nameRegex, pathPrefix string) ([]*iam.Role, error) {
+	var re *regexp.Regexp
+
+	if nameRegex != "" {
+		var err error
+
+		re, err = regexp.Compile(nameRegex)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid name regex %q: %w", nameRegex, err)
+		}
+	}
+
+	input := &iam.ListRolesInput{}
+
+	if pathPrefix != "" {
+		input.PathPrefix = aws.String(pathPrefix)
+	}
+
+	var results []*iam.Role
+
+	err := conn.ListRolesPagesWithContext(ctx, input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, role := range page.Roles {
+			if role == nil {
+				continue
+			}
+
+			if re != nil && !re.MatchString(aws.StringValue(role.RoleName)) {
+				continue
+			}
+
+			results = append(results, role)
+		}
+
+		return !lastPage
+	})
+
+	return results, err
+}
+
 func FindServiceSpecificCredential(ctx context.Context, conn *iam.IAM, serviceName, userName, credID string) (*iam.ServiceSpecificCredentialMetadata, error) {
 	input := &iam.ListServiceSpecificCredentialsInput{
 		ServiceName: aws.String(serviceName),
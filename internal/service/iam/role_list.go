@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// listRoles lists existing IAM roles.
+// The supported filters are `name_regex` and `path_prefix`, with the same meaning as in the aws_iam_roles data source.
+//
+// @ListResource("aws_iam_role", name="Role")
+func listRoles(ctx context.Context, meta any, filters map[string]string) ([]types.ListResult, error) {
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	var nameRegex, pathPrefix string
+
+	for k, v := range filters {
+		switch k {
+		case "name_regex":
+			nameRegex = v
+		case "path_prefix":
+			pathPrefix = v
+		default:
+			return nil, fmt.Errorf("listing IAM Roles: unsupported filter: %s", k)
+		}
+	}
+
+	roles, err := FindRoles(ctx, conn, nameRegex, pathPrefix)
+
+	if err != nil {
+		return nil, fmt.Errorf("listing IAM Roles: %w", err)
+	}
+
+	var results []types.ListResult
+
+	for _, role := range roles {
+		name := aws.StringValue(role.RoleName)
+
+		results = append(results, types.ListResult{
+			DisplayName: name,
+			ImportID:    name,
+		})
+	}
+
+	return results, nil
+}
@@ -19,7 +19,6 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
@@ -27,7 +26,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &sweep.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -35,18 +34,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_instance_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_instance_profile", &sweep.Sweeper{
 		Name:         "aws_iam_instance_profile",
 		F:            sweepInstanceProfile,
 		Dependencies: []string{"aws_iam_role"},
 	})
 
-	resource.AddTestSweepers("aws_iam_openid_connect_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_openid_connect_provider", &sweep.Sweeper{
 		Name: "aws_iam_openid_connect_provider",
 		F:    sweepOpenIDConnectProvider,
 	})
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &sweep.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -56,7 +55,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &sweep.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -86,32 +85,32 @@ func init() {
 		F: sweepRoles,
 	})
 
-	resource.AddTestSweepers("aws_iam_saml_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_saml_provider", &sweep.Sweeper{
 		Name: "aws_iam_saml_provider",
 		F:    sweepSAMLProvider,
 	})
 
-	resource.AddTestSweepers("aws_iam_service_specific_credential", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_service_specific_credential", &sweep.Sweeper{
 		Name: "aws_iam_service_specific_credential",
 		F:    sweepServiceSpecificCredentials,
 	})
 
-	resource.AddTestSweepers("aws_iam_signing_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_signing_certificate", &sweep.Sweeper{
 		Name: "aws_iam_signing_certificate",
 		F:    sweepSigningCertificates,
 	})
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &sweep.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
-	resource.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_service_linked_role", &sweep.Sweeper{
 		Name: "aws_iam_service_linked_role",
 		F:    sweepServiceLinkedRoles,
 	})
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &sweep.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -122,14 +121,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_virtual_mfa_device", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_virtual_mfa_device", &sweep.Sweeper{
 		Name: "aws_iam_virtual_mfa_device",
 		F:    sweepVirtualMFADevice,
 	})
 }
 
-func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -244,8 +242,7 @@ func sweepGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepInstanceProfile(region string) error {
-	ctx := sweep.Context(region)
+func sweepInstanceProfile(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -302,8 +299,7 @@ func sweepInstanceProfile(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepOpenIDConnectProvider(region string) error {
-	ctx := sweep.Context(region)
+func sweepOpenIDConnectProvider(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -342,8 +338,7 @@ func sweepOpenIDConnectProvider(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepServiceSpecificCredentials(region string) error {
-	ctx := sweep.Context(region)
+func sweepServiceSpecificCredentials(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -409,8 +404,7 @@ func sweepServiceSpecificCredentials(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -481,8 +475,7 @@ func (ps policySweeper) Delete(ctx context.Context, timeout time.Duration, optFn
 	return nil
 }
 
-func sweepRoles(region string) error {
-	ctx := sweep.Context(region)
+func sweepRoles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -539,8 +532,7 @@ func sweepRoles(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSAMLProvider(region string) error {
-	ctx := sweep.Context(region)
+func sweepSAMLProvider(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -579,8 +571,7 @@ func sweepSAMLProvider(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepServerCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepServerCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -613,8 +604,7 @@ func sweepServerCertificates(region string) error {
 	return nil
 }
 
-func sweepServiceLinkedRoles(region string) error {
-	ctx := sweep.Context(region)
+func sweepServiceLinkedRoles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -668,8 +658,7 @@ func sweepServiceLinkedRoles(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
+func sweepUsers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -908,8 +897,7 @@ func roleNameFilter(name string) bool {
 	return false
 }
 
-func sweepVirtualMFADevice(region string) error {
-	ctx := sweep.Context(region)
+func sweepVirtualMFADevice(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -966,8 +954,7 @@ func sweepVirtualMFADevice(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSigningCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepSigningCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -61,6 +62,23 @@ func ResourceUserLoginProfile() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(5, 128),
 			},
+			// Changes to password_wo are never planned, so password_wo_version forces replacement.
+			// ForceNew is set only because the resource has no Update.
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"pgp_key"},
+				RequiredWith:  []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"password_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 
 			"key_fingerprint": {
 				Type:     schema.TypeString,
@@ -137,10 +155,14 @@ func resourceUserLoginProfileCreate(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).IAMConn(ctx)
 	username := d.Get("user").(string)
 
-	passwordLength := d.Get("password_length").(int)
-	initialPassword, err := GeneratePassword(passwordLength)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating IAM User Login Profile for %q: %s", username, err)
+	initialPassword, writeOnly := sdkv2.GetWriteOnlyString(d, "password_wo")
+	if !writeOnly {
+		passwordLength := d.Get("password_length").(int)
+		var err error
+		initialPassword, err = GeneratePassword(passwordLength)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IAM User Login Profile for %q: %s", username, err)
+		}
 	}
 
 	request := &iam.CreateLoginProfileInput{
@@ -169,7 +191,7 @@ func resourceUserLoginProfileCreate(ctx context.Context, d *schema.ResourceData,
 
 		d.Set("key_fingerprint", fingerprint)
 		d.Set("encrypted_password", encrypted)
-	} else {
+	} else if !writeOnly {
 		d.Set("password", initialPassword)
 	}
 
@@ -7,55 +7,54 @@
 package imagebuilder
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_imagebuilder_component", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_component", &sweep.Sweeper{
 		Name: "aws_imagebuilder_component",
 		F:    sweepComponents,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_distribution_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_distribution_configuration", &sweep.Sweeper{
 		Name: "aws_imagebuilder_distribution_configuration",
 		F:    sweepDistributionConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_pipeline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image_pipeline", &sweep.Sweeper{
 		Name: "aws_imagebuilder_image_pipeline",
 		F:    sweepImagePipelines,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_recipe", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image_recipe", &sweep.Sweeper{
 		Name: "aws_imagebuilder_image_recipe",
 		F:    sweepImageRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_container_recipe", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_container_recipe", &sweep.Sweeper{
 		Name: "aws_imagebuilder_container_recipe",
 		F:    sweepContainerRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image", &sweep.Sweeper{
 		Name: "aws_imagebuilder_image",
 		F:    sweepImages,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &sweep.Sweeper{
 		Name: "aws_imagebuilder_infrastructure_configuration",
 		F:    sweepInfrastructureConfigurations,
 	})
 }
 
-func sweepComponents(region string) error {
-	ctx := sweep.Context(region)
+func sweepComponents(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -131,8 +130,7 @@ func sweepComponents(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDistributionConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepDistributionConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -180,8 +178,7 @@ func sweepDistributionConfigurations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepImagePipelines(region string) error {
-	ctx := sweep.Context(region)
+func sweepImagePipelines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -229,8 +226,7 @@ func sweepImagePipelines(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepImageRecipes(region string) error {
-	ctx := sweep.Context(region)
+func sweepImageRecipes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -280,8 +276,7 @@ func sweepImageRecipes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepContainerRecipes(region string) error {
-	ctx := sweep.Context(region)
+func sweepContainerRecipes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -331,8 +326,7 @@ func sweepContainerRecipes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepImages(region string) error {
-	ctx := sweep.Context(region)
+func sweepImages(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -412,8 +406,7 @@ func sweepImages(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepInfrastructureConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepInfrastructureConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
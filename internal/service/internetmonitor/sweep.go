@@ -7,25 +7,24 @@
 package internetmonitor
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/internetmonitor"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_internetmonitor_monitor", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_internetmonitor_monitor", &sweep.Sweeper{
 		Name: "aws_internetmonitor_monitor",
 		F:    sweepMonitors,
 	})
 }
 
-func sweepMonitors(region string) error {
-	ctx := sweep.Context(region)
+func sweepMonitors(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
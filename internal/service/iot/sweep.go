@@ -7,6 +7,7 @@
 package iot
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,12 +15,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_certificate", &sweep.Sweeper{
 		Name: "aws_iot_certificate",
 		F:    sweepCertifcates,
 		Dependencies: []string{
@@ -28,12 +28,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy_attachment", &sweep.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepPolicyAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy", &sweep.Sweeper{
 		Name: "aws_iot_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -41,47 +41,46 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_role_alias", &sweep.Sweeper{
 		Name: "aws_iot_role_alias",
 		F:    sweepRoleAliases,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_principal_attachment", &sweep.Sweeper{
 		Name: "aws_iot_thing_principal_attachment",
 		F:    sweepThingPrincipalAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing", &sweep.Sweeper{
 		Name:         "aws_iot_thing",
 		F:            sweepThings,
 		Dependencies: []string{"aws_iot_thing_principal_attachment"},
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_group", &sweep.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepThingGroups,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_type", &sweep.Sweeper{
 		Name:         "aws_iot_thing_type",
 		F:            sweepThingTypes,
 		Dependencies: []string{"aws_iot_thing"},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule", &sweep.Sweeper{
 		Name:         "aws_iot_topic_rule",
 		F:            sweepTopicRules,
 		Dependencies: []string{"aws_iot_topic_rule_destination"},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule_destination", &sweep.Sweeper{
 		Name: "aws_iot_topic_rule_destination",
 		F:    sweepTopicRuleDestinations,
 	})
 }
 
-func sweepCertifcates(region string) error {
-	ctx := sweep.Context(region)
+func sweepCertifcates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -127,8 +126,7 @@ func sweepCertifcates(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepPolicyAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepPolicyAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -194,8 +192,7 @@ func sweepPolicyAttachments(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -241,8 +238,7 @@ func sweepPolicies(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRoleAliases(region string) error {
-	ctx := sweep.Context(region)
+func sweepRoleAliases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -288,8 +284,7 @@ func sweepRoleAliases(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepThingPrincipalAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepThingPrincipalAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -355,8 +350,7 @@ func sweepThingPrincipalAttachments(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepThings(region string) error {
-	ctx := sweep.Context(region)
+func sweepThings(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -402,8 +396,7 @@ func sweepThings(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepThingTypes(region string) error {
-	ctx := sweep.Context(region)
+func sweepThingTypes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -449,8 +442,7 @@ func sweepThingTypes(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepTopicRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepTopicRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -497,8 +489,7 @@ func sweepTopicRules(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepThingGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepThingGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -541,8 +532,7 @@ func sweepThingGroups(region string) error {
 	return nil
 }
 
-func sweepTopicRuleDestinations(region string) error {
-	ctx := sweep.Context(region)
+func sweepTopicRuleDestinations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
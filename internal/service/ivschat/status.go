@@ -48,8 +48,8 @@ func statusRoom(ctx context.Context, conn *ivschat.Client, id string, updateDeta
 		if updateDetails == nil {
 			return out, statusNormal, nil
 		} else {
-			if (updateDetails.MaximumMessageLength != 0 && updateDetails.MaximumMessageLength == out.MaximumMessageLength) ||
-				(updateDetails.MaximumMessageRatePerSecond != 0 && updateDetails.MaximumMessageRatePerSecond == out.MaximumMessageRatePerSecond) ||
+			if (aws.ToInt32(updateDetails.MaximumMessageLength) != 0 && aws.ToInt32(updateDetails.MaximumMessageLength) == aws.ToInt32(out.MaximumMessageLength)) ||
+				(aws.ToInt32(updateDetails.MaximumMessageRatePerSecond) != 0 && aws.ToInt32(updateDetails.MaximumMessageRatePerSecond) == aws.ToInt32(out.MaximumMessageRatePerSecond)) ||
 				(updateDetails.MessageReviewHandler != nil && out.MessageReviewHandler != nil &&
 					(updateDetails.MessageReviewHandler.FallbackResult == out.MessageReviewHandler.FallbackResult || aws.ToString(updateDetails.MessageReviewHandler.Uri) == aws.ToString(out.MessageReviewHandler.Uri))) ||
 				(updateDetails.Name != nil && aws.ToString(updateDetails.Name) == aws.ToString(out.Name)) ||
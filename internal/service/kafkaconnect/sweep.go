@@ -7,22 +7,22 @@
 package kafkaconnect
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kafkaconnect"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mskconnect_connector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mskconnect_connector", &sweep.Sweeper{
 		Name: "aws_mskconnect_connector",
 		F:    sweepConnectors,
 	})
 
-	resource.AddTestSweepers("aws_mskconnect_custom_plugin", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mskconnect_custom_plugin", &sweep.Sweeper{
 		Name: "aws_mskconnect_custom_plugin",
 		F:    sweepCustomPlugins,
 		Dependencies: []string{
@@ -31,8 +31,7 @@ func init() {
 	})
 }
 
-func sweepConnectors(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnectors(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -75,8 +74,7 @@ func sweepConnectors(region string) error {
 	return nil
 }
 
-func sweepCustomPlugins(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomPlugins(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
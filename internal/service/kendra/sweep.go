@@ -7,26 +7,25 @@
 package kendra
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kendra"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kendra_index", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kendra_index", &sweep.Sweeper{
 		Name: "aws_kendra_index",
 		F:    sweepIndex,
 	})
 }
 
-func sweepIndex(region string) error {
-	ctx := sweep.Context(region)
+func sweepIndex(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -7,26 +7,25 @@
 package keyspaces
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/keyspaces"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
 	// No need to have separate sweeper for table as would be destroyed as part of keyspace
-	resource.AddTestSweepers("aws_keyspaces_keyspace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_keyspaces_keyspace", &sweep.Sweeper{
 		Name: "aws_keyspaces_keyspace",
 		F:    sweepKeyspaces,
 	})
 }
 
-func sweepKeyspaces(region string) error { // nosemgrep:ci.keyspaces-in-func-name
-	ctx := sweep.Context(region)
+func sweepKeyspaces(ctx context.Context, region string) error { // nosemgrep:ci.keyspaces-in-func-name
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
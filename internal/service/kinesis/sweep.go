@@ -7,24 +7,23 @@
 package kinesis
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kinesis_stream", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kinesis_stream", &sweep.Sweeper{
 		Name: "aws_kinesis_stream",
 		F:    sweepStreams,
 	})
 }
 
-func sweepStreams(region string) error {
-	ctx := sweep.Context(region)
+func sweepStreams(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,6 +7,7 @@
 package kinesisanalyticsv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,19 +15,17 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kinesisanalyticsv2_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kinesisanalyticsv2_application", &sweep.Sweeper{
 		Name: "aws_kinesisanalyticsv2_application",
 		F:    sweepApplication,
 	})
 }
 
-func sweepApplication(region string) error {
-	ctx := sweep.Context(region)
+func sweepApplication(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
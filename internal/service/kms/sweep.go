@@ -7,6 +7,7 @@
 package kms
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,21 +15,19 @@ import (
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kms_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kms_key", &sweep.Sweeper{
 		Name: "aws_kms_key",
 		F:    sweepKeys,
 	})
 }
 
-func sweepKeys(region string) error {
-	ctx := sweep.Context(region)
+func sweepKeys(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
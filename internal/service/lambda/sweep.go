@@ -7,6 +7,7 @@
 package lambda
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -14,17 +15,16 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lambda_function", &sweep.Sweeper{
 		Name: "aws_lambda_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lambda_layer", &sweep.Sweeper{
 		Name: "aws_lambda_layer",
 		F:    sweepLayerVersions,
 		Dependencies: []string{
@@ -33,8 +33,7 @@ func init() {
 	})
 }
 
-func sweepFunctions(region string) error {
-	ctx := sweep.Context(region)
+func sweepFunctions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -78,8 +77,7 @@ func sweepFunctions(region string) error {
 	return nil
 }
 
-func sweepLayerVersions(region string) error {
-	ctx := sweep.Context(region)
+func sweepLayerVersions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,43 +7,42 @@
 package lexmodels
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lex_bot_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lex_bot_alias", &sweep.Sweeper{
 		Name: "aws_lex_bot_alias",
 		F:    sweepBotAliases,
 	})
 
-	resource.AddTestSweepers("aws_lex_bot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lex_bot", &sweep.Sweeper{
 		Name:         "aws_lex_bot",
 		F:            sweepBots,
 		Dependencies: []string{"aws_lex_bot_alias"},
 	})
 
-	resource.AddTestSweepers("aws_lex_intent", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lex_intent", &sweep.Sweeper{
 		Name:         "aws_lex_intent",
 		F:            sweepIntents,
 		Dependencies: []string{"aws_lex_bot"},
 	})
 
-	resource.AddTestSweepers("aws_lex_slot_type", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lex_slot_type", &sweep.Sweeper{
 		Name:         "aws_lex_slot_type",
 		F:            sweepSlotTypes,
 		Dependencies: []string{"aws_lex_intent"},
 	})
 }
 
-func sweepBotAliases(region string) error {
-	ctx := sweep.Context(region)
+func sweepBotAliases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -116,8 +115,7 @@ func sweepBotAliases(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepBots(region string) error {
-	ctx := sweep.Context(region)
+func sweepBots(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -163,8 +161,7 @@ func sweepBots(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepIntents(region string) error {
-	ctx := sweep.Context(region)
+func sweepIntents(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -210,8 +207,7 @@ func sweepIntents(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSlotTypes(region string) error {
-	ctx := sweep.Context(region)
+func sweepSlotTypes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -7,24 +7,23 @@
 package licensemanager
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_licensemanager_license_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_licensemanager_license_configuration", &sweep.Sweeper{
 		Name: "aws_licensemanager_license_configuration",
 		F:    sweepLicenseConfigurations,
 	})
 }
 
-func sweepLicenseConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepLicenseConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,50 +7,49 @@
 package location
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/locationservice"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_location_geofence_collection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_geofence_collection", &sweep.Sweeper{
 		Name: "aws_location_geofence_collection",
 		F:    sweepGeofenceCollections,
 	})
 
-	resource.AddTestSweepers("aws_location_map", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_map", &sweep.Sweeper{
 		Name: "aws_location_map",
 		F:    sweepMaps,
 	})
 
-	resource.AddTestSweepers("aws_location_place_index", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_place_index", &sweep.Sweeper{
 		Name: "aws_location_place_index",
 		F:    sweepPlaceIndexes,
 	})
 
-	resource.AddTestSweepers("aws_location_route_calculator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_route_calculator", &sweep.Sweeper{
 		Name: "aws_location_route_calculator",
 		F:    sweepRouteCalculators,
 	})
 
-	resource.AddTestSweepers("aws_location_tracker", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_tracker", &sweep.Sweeper{
 		Name: "aws_location_tracker",
 		F:    sweepTrackers,
 	})
 
-	resource.AddTestSweepers("aws_location_tracker_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_tracker_association", &sweep.Sweeper{
 		Name: "aws_location_tracker_association",
 		F:    sweepTrackerAssociations,
 	})
 }
 
-func sweepGeofenceCollections(region string) error {
-	ctx := sweep.Context(region)
+func sweepGeofenceCollections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -97,8 +96,7 @@ func sweepGeofenceCollections(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepMaps(region string) error {
-	ctx := sweep.Context(region)
+func sweepMaps(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -145,8 +143,7 @@ func sweepMaps(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepPlaceIndexes(region string) error {
-	ctx := sweep.Context(region)
+func sweepPlaceIndexes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -193,8 +190,7 @@ func sweepPlaceIndexes(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRouteCalculators(region string) error {
-	ctx := sweep.Context(region)
+func sweepRouteCalculators(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -241,8 +237,7 @@ func sweepRouteCalculators(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepTrackers(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrackers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -289,8 +284,7 @@ func sweepTrackers(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepTrackerAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrackerAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
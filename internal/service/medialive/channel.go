@@ -1188,8 +1188,8 @@ func expandInputAttachmentInputSettings(tfList []interface{}) *types.InputSettin
 	if v, ok := m["denoise_filter"].(string); ok && v != "" {
 		out.DenoiseFilter = types.InputDenoiseFilter(v)
 	}
-	if v, ok := m["filter_strength"].(int); ok {
-		out.FilterStrength = int32(v)
+	if v, ok := m["filter_strength"].(int); ok && v != 0 {
+		out.FilterStrength = aws.Int32(int32(v))
 	}
 	if v, ok := m["input_filter"].(string); ok && v != "" {
 		out.InputFilter = types.InputFilter(v)
@@ -1197,8 +1197,8 @@ func expandInputAttachmentInputSettings(tfList []interface{}) *types.InputSettin
 	if v, ok := m["network_input_settings"].([]interface{}); ok && len(v) > 0 {
 		out.NetworkInputSettings = expandInputAttachmentInputSettingsNetworkInputSettings(v)
 	}
-	if v, ok := m["scte35_pid"].(int); ok {
-		out.Scte35Pid = int32(v)
+	if v, ok := m["scte35_pid"].(int); ok && v != 0 {
+		out.Scte35Pid = aws.Int32(int32(v))
 	}
 	if v, ok := m["smpte2038_data_preference"].(string); ok && v != "" {
 		out.Smpte2038DataPreference = types.Smpte2038DataPreference(v)
@@ -1300,8 +1300,8 @@ func expandInputAttachmentInputSettingsAudioSelectorsSelectorSettingsAudioPidSel
 	m := tfList[0].(map[string]interface{})
 
 	var out types.AudioPidSelection
-	if v, ok := m["pid"].(int); ok {
-		out.Pid = int32(v)
+	if v, ok := m["pid"].(int); ok && v != 0 {
+		out.Pid = aws.Int32(int32(v))
 	}
 
 	return &out
@@ -1338,8 +1338,8 @@ func expandInputAttachmentInputSettingsAudioSelectorsSelectorSettingsAudioTrackS
 		}
 
 		var o types.AudioTrack
-		if v, ok := m["track"].(int); ok {
-			o.Track = int32(v)
+		if v, ok := m["track"].(int); ok && v != 0 {
+			o.Track = aws.Int32(int32(v))
 		}
 
 		out = append(out, o)
@@ -1433,8 +1433,8 @@ func expandInputAttachmentInputSettingsCaptionSelectorsSelectorSettingsAncillary
 	m := tfList[0].(map[string]interface{})
 
 	var out types.AncillarySourceSettings
-	if v, ok := m["source_ancillary_channel_number"].(int); ok {
-		out.SourceAncillaryChannelNumber = int32(v)
+	if v, ok := m["source_ancillary_channel_number"].(int); ok && v != 0 {
+		out.SourceAncillaryChannelNumber = aws.Int32(int32(v))
 	}
 
 	return &out
@@ -1451,8 +1451,8 @@ func expandInputAttachmentInputSettingsCaptionSelectorsSelectorSettingsDvbSubSou
 	if v, ok := m["ocr_language"].(string); ok && v != "" {
 		out.OcrLanguage = types.DvbSubOcrLanguage(v)
 	}
-	if v, ok := m["pid"].(int); ok {
-		out.Pid = int32(v)
+	if v, ok := m["pid"].(int); ok && v != 0 {
+		out.Pid = aws.Int32(int32(v))
 	}
 
 	return &out
@@ -1472,8 +1472,8 @@ func expandInputAttachmentInputSettingsCaptionSelectorsSelectorSettingsEmbeddedS
 	if v, ok := m["scte20_detection"].(string); ok && v != "" {
 		out.Scte20Detection = types.EmbeddedScte20Detection(v)
 	}
-	if v, ok := m["source_608_channel_number"].(int); ok {
-		out.Source608ChannelNumber = int32(v)
+	if v, ok := m["source_608_channel_number"].(int); ok && v != 0 {
+		out.Source608ChannelNumber = aws.Int32(int32(v))
 	}
 
 	return &out
@@ -1490,8 +1490,8 @@ func expandInputAttachmentInputSettingsCaptionSelectorsSelectorSettingsScte20Sou
 	if v, ok := m["convert_608_to_708"].(string); ok && v != "" {
 		out.Convert608To708 = types.Scte20Convert608To708(v)
 	}
-	if v, ok := m["source_608_channel_number"].(int); ok {
-		out.Source608ChannelNumber = int32(v)
+	if v, ok := m["source_608_channel_number"].(int); ok && v != 0 {
+		out.Source608ChannelNumber = aws.Int32(int32(v))
 	}
 
 	return &out
@@ -1508,8 +1508,8 @@ func expandInputAttachmentInputSettingsCaptionSelectorsSelectorSettingsScte27Sou
 	if v, ok := m["ocr_language"].(string); ok && v != "" {
 		out.OcrLanguage = types.Scte27OcrLanguage(v)
 	}
-	if v, ok := m["pid"].(int); ok {
-		out.Pid = int32(v)
+	if v, ok := m["pid"].(int); ok && v != 0 {
+		out.Pid = aws.Int32(int32(v))
 	}
 
 	return &out
@@ -1541,17 +1541,17 @@ func expandInputAttachmentInputSettingsCaptionSelectorsSelectorSettingsTeletextS
 	m := tfList[0].(map[string]interface{})
 
 	var out types.CaptionRectangle
-	if v, ok := m["height"].(float32); ok {
-		out.Height = float64(v)
+	if v, ok := m["height"].(float32); ok && v != 0 {
+		out.Height = aws.Float64(float64(v))
 	}
-	if v, ok := m["left_offset"].(float32); ok {
-		out.LeftOffset = float64(v)
+	if v, ok := m["left_offset"].(float32); ok && v != 0 {
+		out.LeftOffset = aws.Float64(float64(v))
 	}
-	if v, ok := m["top_offset"].(float32); ok {
-		out.TopOffset = float64(v)
+	if v, ok := m["top_offset"].(float32); ok && v != 0 {
+		out.TopOffset = aws.Float64(float64(v))
 	}
-	if v, ok := m["width"].(float32); ok {
-		out.Width = float64(v)
+	if v, ok := m["width"].(float32); ok && v != 0 {
+		out.Width = aws.Float64(float64(v))
 	}
 
 	return &out
@@ -1583,17 +1583,17 @@ func expandNetworkInputSettingsHLSInputSettings(tfList []interface{}) *types.Hls
 	m := tfList[0].(map[string]interface{})
 
 	var out types.HlsInputSettings
-	if v, ok := m["bandwidth"].(int); ok {
-		out.Bandwidth = int32(v)
+	if v, ok := m["bandwidth"].(int); ok && v != 0 {
+		out.Bandwidth = aws.Int32(int32(v))
 	}
-	if v, ok := m["buffer_segments"].(int); ok {
-		out.BufferSegments = int32(v)
+	if v, ok := m["buffer_segments"].(int); ok && v != 0 {
+		out.BufferSegments = aws.Int32(int32(v))
 	}
-	if v, ok := m["retries"].(int); ok {
-		out.Retries = int32(v)
+	if v, ok := m["retries"].(int); ok && v != 0 {
+		out.Retries = aws.Int32(int32(v))
 	}
-	if v, ok := m["retry_interval"].(int); ok {
-		out.RetryInterval = int32(v)
+	if v, ok := m["retry_interval"].(int); ok && v != 0 {
+		out.RetryInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["scte35_source"].(string); ok && v != "" {
 		out.Scte35Source = types.HlsScte35SourceType(v)
@@ -1631,10 +1631,10 @@ func flattenInputAttachmentsInputSettings(in *types.InputSettings) []interface{}
 		"caption_selector":          flattenInputAttachmentsInputSettingsCaptionSelectors(in.CaptionSelectors),
 		"deblock_filter":            string(in.DeblockFilter),
 		"denoise_filter":            string(in.DenoiseFilter),
-		"filter_strength":           int(in.FilterStrength),
+		"filter_strength":           int(aws.ToInt32(in.FilterStrength)),
 		"input_filter":              string(in.InputFilter),
 		"network_input_settings":    flattenInputAttachmentsInputSettingsNetworkInputSettings(in.NetworkInputSettings),
-		"scte35_pid":                int(in.Scte35Pid),
+		"scte35_pid":                int(aws.ToInt32(in.Scte35Pid)),
 		"smpte2038_data_preference": string(in.Smpte2038DataPreference),
 		"source_end_behavior":       string(in.SourceEndBehavior),
 	}
@@ -1708,7 +1708,7 @@ func flattenInputAttachmentsInputSettingsAudioSelectorsSelectorSettingsAudioPidS
 	}
 
 	m := map[string]interface{}{
-		"pid": int(in.Pid),
+		"pid": int(aws.ToInt32(in.Pid)),
 	}
 
 	return []interface{}{m}
@@ -1748,7 +1748,7 @@ func flattenInputAttachmentsInputSettingsAudioSelectorsSelectorSettingsAudioTrac
 
 	for _, v := range tfList {
 		m := map[string]interface{}{
-			"track": int(v.Track),
+			"track": int(aws.ToInt32(v.Track)),
 		}
 
 		out = append(out, m)
@@ -1801,7 +1801,7 @@ func flattenInputAttachmentsInputSettingsCaptionSelectorsSelectorSettingsAncilla
 	}
 
 	m := map[string]interface{}{
-		"source_ancillary_channel_number": int(in.SourceAncillaryChannelNumber),
+		"source_ancillary_channel_number": int(aws.ToInt32(in.SourceAncillaryChannelNumber)),
 	}
 
 	return []interface{}{m}
@@ -1814,7 +1814,7 @@ func flattenInputAttachmentsInputSettingsCaptionSelectorsSelectorSettingsDvbSubS
 
 	m := map[string]interface{}{
 		"ocr_language": string(in.OcrLanguage),
-		"pid":          int(in.Pid),
+		"pid":          int(aws.ToInt32(in.Pid)),
 	}
 
 	return []interface{}{m}
@@ -1828,7 +1828,7 @@ func flattenInputAttachmentsInputSettingsCaptionSelectorsSelectorSettingsEmbedde
 	m := map[string]interface{}{
 		"convert_608_to_708":        string(in.Convert608To708),
 		"scte20_detection":          string(in.Scte20Detection),
-		"source_608_channel_number": int(in.Source608ChannelNumber),
+		"source_608_channel_number": int(aws.ToInt32(in.Source608ChannelNumber)),
 	}
 
 	return []interface{}{m}
@@ -1841,7 +1841,7 @@ func flattenInputAttachmentsInputSettingsCaptionSelectorsSelectorSettingsScte20S
 
 	m := map[string]interface{}{
 		"convert_608_to_708":        string(in.Convert608To708),
-		"source_608_channel_number": int(in.Source608ChannelNumber),
+		"source_608_channel_number": int(aws.ToInt32(in.Source608ChannelNumber)),
 	}
 
 	return []interface{}{m}
@@ -1854,7 +1854,7 @@ func flattenInputAttachmentsInputSettingsCaptionSelectorsSelectorSettingsScte27S
 
 	m := map[string]interface{}{
 		"ocr_language": string(in.OcrLanguage),
-		"pid":          int(in.Pid),
+		"pid":          int(aws.ToInt32(in.Pid)),
 	}
 
 	return []interface{}{m}
@@ -1879,10 +1879,10 @@ func flattenInputAttachmentsInputSettingsCaptionSelectorsSelectorSettingsTeletex
 	}
 
 	m := map[string]interface{}{
-		"height":      float32(in.Height),
-		"left_offset": float32(in.LeftOffset),
-		"top_offset":  float32(in.TopOffset),
-		"width":       float32(in.Width),
+		"height":      float32(aws.ToFloat64(in.Height)),
+		"left_offset": float32(aws.ToFloat64(in.LeftOffset)),
+		"top_offset":  float32(aws.ToFloat64(in.TopOffset)),
+		"width":       float32(aws.ToFloat64(in.Width)),
 	}
 
 	return []interface{}{m}
@@ -1907,10 +1907,10 @@ func flattenNetworkInputSettingsHLSInputSettings(in *types.HlsInputSettings) []i
 	}
 
 	m := map[string]interface{}{
-		"bandwidth":       int(in.Bandwidth),
-		"buffer_segments": int(in.BufferSegments),
-		"retries":         int(in.Retries),
-		"retry_interval":  int(in.RetryInterval),
+		"bandwidth":       int(aws.ToInt32(in.Bandwidth)),
+		"buffer_segments": int(aws.ToInt32(in.BufferSegments)),
+		"retries":         int(aws.ToInt32(in.Retries)),
+		"retry_interval":  int(aws.ToInt32(in.RetryInterval)),
 		"scte35_source":   string(in.Scte35Source),
 	}
 
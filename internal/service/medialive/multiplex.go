@@ -412,17 +412,17 @@ func expandMultiplexSettings(tfList []interface{}) *types.MultiplexSettings {
 
 	s := types.MultiplexSettings{}
 
-	if v, ok := m["transport_stream_bitrate"]; ok {
-		s.TransportStreamBitrate = int32(v.(int))
+	if v, ok := m["transport_stream_bitrate"].(int); ok && v != 0 {
+		s.TransportStreamBitrate = aws.Int32(int32(v))
 	}
-	if v, ok := m["transport_stream_id"]; ok {
-		s.TransportStreamId = int32(v.(int))
+	if v, ok := m["transport_stream_id"].(int); ok && v != 0 {
+		s.TransportStreamId = aws.Int32(int32(v))
 	}
-	if val, ok := m["maximum_video_buffer_delay_milliseconds"]; ok {
-		s.MaximumVideoBufferDelayMilliseconds = int32(val.(int))
+	if val, ok := m["maximum_video_buffer_delay_milliseconds"].(int); ok && val != 0 {
+		s.MaximumVideoBufferDelayMilliseconds = aws.Int32(int32(val))
 	}
-	if val, ok := m["transport_stream_reserved_bitrate"]; ok {
-		s.TransportStreamReservedBitrate = int32(val.(int))
+	if val, ok := m["transport_stream_reserved_bitrate"].(int); ok && val != 0 {
+		s.TransportStreamReservedBitrate = aws.Int32(int32(val))
 	}
 
 	return &s
@@ -34,6 +34,7 @@ import (
 )
 
 // @FrameworkResource
+// @Sweeper(lister=listSweepableMultiplexPrograms)
 func newResourceMultiplexProgram(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &multiplexProgram{}, nil
 }
@@ -406,7 +407,7 @@ func (mps multiplexProgramSettingsObject) expand(ctx context.Context) (*mltypes.
 	data := mps[0]
 
 	l := &mltypes.MultiplexProgramSettings{
-		ProgramNumber:            int32(data.ProgramNumber.ValueInt64()),
+		ProgramNumber:            aws.Int32(int32(data.ProgramNumber.ValueInt64())),
 		PreferredChannelPipeline: mltypes.PreferredChannelPipeline(data.PreferredChannelPipeline.ValueString()),
 	}
 
@@ -463,9 +464,13 @@ func (vs videoSettingsObject) expand(_ context.Context) *mltypes.MultiplexVideoS
 		return nil
 	}
 
-	return &mltypes.MultiplexVideoSettings{
-		ConstantBitrate: int32(vs[0].ConstantBitrate.ValueInt64()),
+	l := &mltypes.MultiplexVideoSettings{}
+
+	if v := vs[0].ConstantBitrate.ValueInt64(); v != 0 {
+		l.ConstantBitrate = aws.Int32(int32(v))
 	}
+
+	return l
 }
 
 type statmuxSettingsObject []statmuxSettings
@@ -475,11 +480,19 @@ func (sms statmuxSettingsObject) expand(_ context.Context) *mltypes.MultiplexSta
 		return nil
 	}
 
-	return &mltypes.MultiplexStatmuxVideoSettings{
-		MaximumBitrate: int32(sms[0].MaximumBitrate.ValueInt64()),
-		MinimumBitrate: int32(sms[0].MinimumBitrate.ValueInt64()),
-		Priority:       int32(sms[0].Priority.ValueInt64()),
+	l := &mltypes.MultiplexStatmuxVideoSettings{}
+
+	if v := sms[0].MaximumBitrate.ValueInt64(); v != 0 {
+		l.MaximumBitrate = aws.Int32(int32(v))
 	}
+	if v := sms[0].MinimumBitrate.ValueInt64(); v != 0 {
+		l.MinimumBitrate = aws.Int32(int32(v))
+	}
+	if v := sms[0].Priority.ValueInt64(); v != 0 {
+		l.Priority = aws.Int32(int32(v))
+	}
+
+	return l
 }
 
 var (
@@ -515,7 +528,7 @@ func flattenMultiplexProgramSettings(ctx context.Context, mps *mltypes.Multiplex
 	}
 
 	attrs := map[string]attr.Value{}
-	attrs["program_number"] = types.Int64Value(int64(mps.ProgramNumber))
+	attrs["program_number"] = types.Int64Value(int64(aws.ToInt32(mps.ProgramNumber)))
 	attrs["preferred_channel_pipeline"] = flex.StringValueToFrameworkLegacy(ctx, mps.PreferredChannelPipeline)
 	attrs["service_descriptor"] = flattenServiceDescriptor(ctx, mps.ServiceDescriptor)
 	attrs["video_settings"] = flattenVideoSettings(ctx, mps.VideoSettings)
@@ -549,9 +562,9 @@ func flattenStatMuxSettings(_ context.Context, mps *mltypes.MultiplexStatmuxVide
 	}
 
 	attrs := map[string]attr.Value{}
-	attrs["minimum_bitrate"] = types.Int64Value(int64(mps.MinimumBitrate))
-	attrs["maximum_bitrate"] = types.Int64Value(int64(mps.MaximumBitrate))
-	attrs["priority"] = types.Int64Value(int64(mps.Priority))
+	attrs["minimum_bitrate"] = types.Int64Value(int64(aws.ToInt32(mps.MinimumBitrate)))
+	attrs["maximum_bitrate"] = types.Int64Value(int64(aws.ToInt32(mps.MaximumBitrate)))
+	attrs["priority"] = types.Int64Value(int64(aws.ToInt32(mps.Priority)))
 
 	vals := types.ObjectValueMust(statmuxAttrs, attrs)
 
@@ -566,7 +579,7 @@ func flattenVideoSettings(ctx context.Context, mps *mltypes.MultiplexVideoSettin
 	}
 
 	attrs := map[string]attr.Value{}
-	attrs["constant_bitrate"] = types.Int64Value(int64(mps.ConstantBitrate))
+	attrs["constant_bitrate"] = types.Int64Value(int64(aws.ToInt32(mps.ConstantBitrate)))
 	attrs["statmux_settings"] = flattenStatMuxSettings(ctx, mps.StatmuxSettings)
 
 	vals := types.ObjectValueMust(videoSettingsAttrs, attrs)
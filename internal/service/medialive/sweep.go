@@ -7,28 +7,30 @@
 package medialive
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/medialive"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_medialive_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_channel", &sweep.Sweeper{
 		Name: "aws_medialive_channel",
 		F:    sweepChannels,
 	})
 
-	resource.AddTestSweepers("aws_medialive_input", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_input", &sweep.Sweeper{
 		Name: "aws_medialive_input",
 		F:    sweepInputs,
 	})
 
-	resource.AddTestSweepers("aws_medialive_input_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_input_security_group", &sweep.Sweeper{
 		Name: "aws_medialive_input_security_group",
 		F:    sweepInputSecurityGroups,
 		Dependencies: []string{
@@ -36,14 +38,16 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_medialive_multiplex", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_multiplex", &sweep.Sweeper{
 		Name: "aws_medialive_multiplex",
 		F:    sweepMultiplexes,
+		Dependencies: []string{
+			"aws_medialive_multiplex_program",
+		},
 	})
 }
 
-func sweepChannels(region string) error {
-	ctx := sweep.Context(region)
+func sweepChannels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -59,7 +63,7 @@ func sweepChannels(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping MediaLive Channels sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping MediaLive Channels sweep for %s: %s", region, err)
 			return nil
 		}
 
@@ -86,8 +90,7 @@ func sweepChannels(region string) error {
 	return nil
 }
 
-func sweepInputs(region string) error {
-	ctx := sweep.Context(region)
+func sweepInputs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -103,7 +106,7 @@ func sweepInputs(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping MediaLive Inputs sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping MediaLive Inputs sweep for %s: %s", region, err)
 			return nil
 		}
 
@@ -130,8 +133,7 @@ func sweepInputs(region string) error {
 	return nil
 }
 
-func sweepInputSecurityGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepInputSecurityGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -147,7 +149,7 @@ func sweepInputSecurityGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping MediaLive Input Security Groups sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping MediaLive Input Security Groups sweep for %s: %s", region, err)
 			return nil
 		}
 
@@ -174,8 +176,7 @@ func sweepInputSecurityGroups(region string) error {
 	return nil
 }
 
-func sweepMultiplexes(region string) error {
-	ctx := sweep.Context(region)
+func sweepMultiplexes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -191,7 +192,7 @@ func sweepMultiplexes(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping MediaLive Multiplexes sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping MediaLive Multiplexes sweep for %s: %s", region, err)
 			return nil
 		}
 
@@ -217,3 +218,45 @@ func sweepMultiplexes(region string) error {
 
 	return nil
 }
+
+func listSweepableMultiplexPrograms(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MediaLiveClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := medialive.NewListMultiplexesPaginator(conn, &medialive.ListMultiplexesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, multiplex := range page.Multiplexes {
+			multiplexID := aws.ToString(multiplex.Id)
+			input := &medialive.ListMultiplexProgramsInput{
+				MultiplexId: aws.String(multiplexID),
+			}
+
+			pages := medialive.NewListMultiplexProgramsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, fmt.Errorf("listing MediaLive Multiplex Programs (%s): %w", multiplexID, err)
+				}
+
+				for _, v := range page.MultiplexPrograms {
+					programName := aws.ToString(v.ProgramName)
+
+					sweepResources = append(sweepResources, framework.NewSweepResource(newResourceMultiplexProgram, client,
+						framework.NewAttribute("id", fmt.Sprintf("%s/%s", programName, multiplexID)),
+						framework.NewAttribute("multiplex_id", multiplexID),
+						framework.NewAttribute("program_name", programName),
+					))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -7,25 +7,24 @@
 package mediapackage
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/mediapackage"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_media_package_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_media_package_channel", &sweep.Sweeper{
 		Name: "aws_media_package_channel",
 		F:    sweepChannels,
 	})
 }
 
-func sweepChannels(region string) error {
-	ctx := sweep.Context(region)
+func sweepChannels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -7,18 +7,18 @@
 package memorydb
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/memorydb"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_memorydb_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_acl", &sweep.Sweeper{
 		Name: "aws_memorydb_acl",
 		F:    sweepACLs,
 		Dependencies: []string{
@@ -26,12 +26,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_cluster", &sweep.Sweeper{
 		Name: "aws_memorydb_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_parameter_group", &sweep.Sweeper{
 		Name: "aws_memorydb_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -39,7 +39,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_snapshot", &sweep.Sweeper{
 		Name: "aws_memorydb_snapshot",
 		F:    sweepSnapshots,
 		Dependencies: []string{
@@ -47,7 +47,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_subnet_group", &sweep.Sweeper{
 		Name: "aws_memorydb_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -55,7 +55,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_user", &sweep.Sweeper{
 		Name: "aws_memorydb_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -64,8 +64,7 @@ func init() {
 	})
 }
 
-func sweepACLs(region string) error {
-	ctx := sweep.Context(region)
+func sweepACLs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -114,8 +113,7 @@ func sweepACLs(region string) error {
 	return nil
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -158,8 +156,7 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepParameterGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepParameterGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -208,8 +205,7 @@ func sweepParameterGroups(region string) error {
 	return nil
 }
 
-func sweepSnapshots(region string) error {
-	ctx := sweep.Context(region)
+func sweepSnapshots(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -252,8 +248,7 @@ func sweepSnapshots(region string) error {
 	return nil
 }
 
-func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepSubnetGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -302,8 +297,7 @@ func sweepSubnetGroups(region string) error {
 	return nil
 }
 
-func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
+func sweepUsers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
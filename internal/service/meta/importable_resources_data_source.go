@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// @FrameworkDataSource
+func newDataSourceImportableResources(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceImportableResources{}, nil
+}
+
+type dataSourceImportableResources struct {
+	framework.DataSourceWithConfigure
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceImportableResources) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_importable_resources"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceImportableResources) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"filters": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"id": framework.IDAttribute(),
+			"resource_type": schema.StringAttribute{
+				Required: true,
+			},
+			"resources": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: importableResourceAttrTypes},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceImportableResources) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceImportableResourcesData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	typeName := data.ResourceType.ValueString()
+	filters := flex.ExpandFrameworkStringValueMap(ctx, data.Filters)
+
+	results, err := d.Meta().ListResources(ctx, typeName, filters)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing %s resources", typeName), err.Error())
+
+		return
+	}
+
+	elems := make([]attr.Value, 0, len(results))
+	for _, v := range results {
+		elems = append(elems, types.ObjectValueMust(importableResourceAttrTypes, map[string]attr.Value{
+			"display_name": types.StringValue(v.DisplayName),
+			"import_id":    types.StringValue(v.ImportID),
+		}))
+	}
+
+	data.ID = types.StringValue(typeName)
+	data.Resources = types.ListValueMust(types.ObjectType{AttrTypes: importableResourceAttrTypes}, elems)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+var importableResourceAttrTypes = map[string]attr.Type{
+	"display_name": types.StringType,
+	"import_id":    types.StringType,
+}
+
+type dataSourceImportableResourcesData struct {
+	Filters      types.Map    `tfsdk:"filters"`
+	ID           types.String `tfsdk:"id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Resources    types.List   `tfsdk:"resources"`
+}
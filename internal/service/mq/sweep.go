@@ -7,24 +7,23 @@
 package mq
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mq_broker", &sweep.Sweeper{
 		Name: "aws_mq_broker",
 		F:    sweepBrokers,
 	})
 }
 
-func sweepBrokers(region string) error {
-	ctx := sweep.Context(region)
+func sweepBrokers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
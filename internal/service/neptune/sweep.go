@@ -7,6 +7,7 @@
 package neptune
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,18 +15,17 @@ import (
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_neptune_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_event_subscription", &sweep.Sweeper{
 		Name: "aws_neptune_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_neptune_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_cluster", &sweep.Sweeper{
 		Name: "aws_neptune_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -33,7 +33,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_cluster_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_cluster_instance", &sweep.Sweeper{
 		Name: "aws_neptune_cluster_instance",
 		F:    sweepClusterInstances,
 		Dependencies: []string{
@@ -42,8 +42,7 @@ func init() {
 	})
 }
 
-func sweepEventSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepEventSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -98,8 +97,7 @@ func sweepEventSubscriptions(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -160,8 +158,7 @@ func sweepClusters(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepClusterInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusterInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -7,17 +7,17 @@
 package networkfirewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkfirewall_firewall_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall_policy", &sweep.Sweeper{
 		Name: "aws_networkfirewall_firewall_policy",
 		F:    sweepFirewallPolicies,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_firewall", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall", &sweep.Sweeper{
 		Name: "aws_networkfirewall_firewall",
 		F:    sweepFirewalls,
 		Dependencies: []string{
@@ -33,12 +33,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_logging_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_logging_configuration", &sweep.Sweeper{
 		Name: "aws_networkfirewall_logging_configuration",
 		F:    sweepLoggingConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_rule_group", &sweep.Sweeper{
 		Name: "aws_networkfirewall_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -47,8 +47,7 @@ func init() {
 	})
 }
 
-func sweepFirewallPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepFirewallPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -91,8 +90,7 @@ func sweepFirewallPolicies(region string) error {
 	return nil
 }
 
-func sweepFirewalls(region string) error {
-	ctx := sweep.Context(region)
+func sweepFirewalls(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -135,8 +133,7 @@ func sweepFirewalls(region string) error {
 	return nil
 }
 
-func sweepLoggingConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepLoggingConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -179,8 +176,7 @@ func sweepLoggingConfigurations(region string) error {
 	return nil
 }
 
-func sweepRuleGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepRuleGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -7,18 +7,18 @@
 package networkmanager
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkmanager_global_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_global_network", &sweep.Sweeper{
 		Name: "aws_networkmanager_global_network",
 		F:    sweepGlobalNetworks,
 		Dependencies: []string{
@@ -27,7 +27,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_core_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_core_network", &sweep.Sweeper{
 		Name: "aws_networkmanager_core_network",
 		F:    sweepCoreNetworks,
 		Dependencies: []string{
@@ -38,17 +38,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_connect_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_connect_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_connect_attachment",
 		F:    sweepConnectAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_site_to_site_vpn_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_site_to_site_vpn_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_site_to_site_vpn_attachment",
 		F:    sweepSiteToSiteVPNAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_transit_gateway_peering", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_transit_gateway_peering", &sweep.Sweeper{
 		Name: "aws_networkmanager_transit_gateway_peering",
 		F:    sweepTransitGatewayPeerings,
 		Dependencies: []string{
@@ -56,17 +56,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_transit_gateway_route_table_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_transit_gateway_route_table_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_transit_gateway_route_table_attachment",
 		F:    sweepTransitGatewayRouteTableAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_vpc_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_vpc_attachment",
 		F:    sweepVPCAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_site", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_site", &sweep.Sweeper{
 		Name: "aws_networkmanager_site",
 		F:    sweepSites,
 		Dependencies: []string{
@@ -75,7 +75,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_device", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_device", &sweep.Sweeper{
 		Name: "aws_networkmanager_device",
 		F:    sweepDevices,
 		Dependencies: []string{
@@ -83,7 +83,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_link", &sweep.Sweeper{
 		Name: "aws_networkmanager_link",
 		F:    sweepLinks,
 		Dependencies: []string{
@@ -91,7 +91,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_link_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_link_association", &sweep.Sweeper{
 		Name: "aws_networkmanager_link_association",
 		F:    sweepLinkAssociations,
 		Dependencies: []string{
@@ -99,14 +99,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_connection", &sweep.Sweeper{
 		Name: "aws_networkmanager_connection",
 		F:    sweepConnections,
 	})
 }
 
-func sweepGlobalNetworks(region string) error {
-	ctx := sweep.Context(region)
+func sweepGlobalNetworks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -149,8 +148,7 @@ func sweepGlobalNetworks(region string) error {
 	return nil
 }
 
-func sweepCoreNetworks(region string) error {
-	ctx := sweep.Context(region)
+func sweepCoreNetworks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -193,8 +191,7 @@ func sweepCoreNetworks(region string) error {
 	return nil
 }
 
-func sweepConnectAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnectAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -239,8 +236,7 @@ func sweepConnectAttachments(region string) error {
 	return nil
 }
 
-func sweepSiteToSiteVPNAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepSiteToSiteVPNAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -285,8 +281,7 @@ func sweepSiteToSiteVPNAttachments(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayPeerings(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayPeerings(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -331,8 +326,7 @@ func sweepTransitGatewayPeerings(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayRouteTableAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayRouteTableAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -377,8 +371,7 @@ func sweepTransitGatewayRouteTableAttachments(region string) error {
 	return nil
 }
 
-func sweepVPCAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -423,8 +416,7 @@ func sweepVPCAttachments(region string) error {
 	return nil
 }
 
-func sweepSites(region string) error {
-	ctx := sweep.Context(region)
+func sweepSites(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -491,8 +483,7 @@ func sweepSites(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDevices(region string) error {
-	ctx := sweep.Context(region)
+func sweepDevices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -559,8 +550,7 @@ func sweepDevices(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLinks(region string) error {
-	ctx := sweep.Context(region)
+func sweepLinks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -627,8 +617,7 @@ func sweepLinks(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLinkAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepLinkAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -694,8 +683,7 @@ func sweepLinkAssociations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
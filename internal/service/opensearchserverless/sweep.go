@@ -7,6 +7,7 @@
 package opensearchserverless
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,37 +15,35 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_opensearchserverless_access_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_access_policy", &sweep.Sweeper{
 		Name: "aws_opensearchserverless_access_policy",
 		F:    sweepAccessPolicies,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_collection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_collection", &sweep.Sweeper{
 		Name: "aws_opensearchserverless_collection",
 		F:    sweepCollections,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_security_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_security_config", &sweep.Sweeper{
 		Name: "aws_opensearchserverless_security_config",
 		F:    sweepSecurityConfigs,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_security_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_security_policy", &sweep.Sweeper{
 		Name: "aws_opensearchserverless_security_policy",
 		F:    sweepSecurityPolicies,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_vpc_endpoint", &sweep.Sweeper{
 		Name: "aws_opensearchserverless_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 	})
 }
 
-func sweepAccessPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccessPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -88,8 +87,7 @@ func sweepAccessPolicies(region string) error {
 	return nil
 }
 
-func sweepCollections(region string) error {
-	ctx := sweep.Context(region)
+func sweepCollections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -129,8 +127,7 @@ func sweepCollections(region string) error {
 	return nil
 }
 
-func sweepSecurityConfigs(region string) error {
-	ctx := sweep.Context(region)
+func sweepSecurityConfigs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -172,8 +169,7 @@ func sweepSecurityConfigs(region string) error {
 	return nil
 }
 
-func sweepSecurityPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepSecurityPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -244,8 +240,7 @@ func sweepSecurityPolicies(region string) error {
 	return nil
 }
 
-func sweepVPCEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
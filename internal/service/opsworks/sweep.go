@@ -17,7 +17,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
@@ -25,7 +24,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_opsworks_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_stack", &sweep.Sweeper{
 		Name: "aws_opsworks_stack",
 		F:    sweepStacks,
 		Dependencies: []string{
@@ -36,18 +35,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_application", &sweep.Sweeper{
 		Name: "aws_opsworks_application",
 		F:    sweepApplication,
 	})
 
-	resource.AddTestSweepers("aws_opsworks_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_instance", &sweep.Sweeper{
 		Name: "aws_opsworks_instance",
 		F:    sweepInstance,
 	})
 
 	// This sweep all the custom, ecs, ganglia, etc. layers
-	resource.AddTestSweepers("aws_opsworks_layer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_layer", &sweep.Sweeper{
 		Name: "aws_opsworks_layer",
 		F:    sweepLayers,
 		Dependencies: []string{
@@ -56,7 +55,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_rds_db_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_rds_db_instance", &sweep.Sweeper{
 		Name: "aws_opsworks_rds_db_instance",
 		F:    sweepRDSDBInstance,
 		Dependencies: []string{
@@ -64,14 +63,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_user_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_user_profile", &sweep.Sweeper{
 		Name: "aws_opsworks_user_profile",
 		F:    sweepUserProfiles,
 	})
 }
 
-func sweepApplication(region string) error {
-	ctx := sweep.Context(region)
+func sweepApplication(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -122,8 +120,7 @@ func sweepApplication(region string) error {
 	return sweep.SweepOrchestrator(ctx, sweepResources)
 }
 
-func sweepInstance(region string) error {
-	ctx := sweep.Context(region)
+func sweepInstance(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -175,8 +172,7 @@ func sweepInstance(region string) error {
 	return sweep.SweepOrchestrator(ctx, sweepResources)
 }
 
-func sweepRDSDBInstance(region string) error {
-	ctx := sweep.Context(region)
+func sweepRDSDBInstance(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -228,8 +224,7 @@ func sweepRDSDBInstance(region string) error {
 	return sweep.SweepOrchestrator(ctx, sweepResources)
 }
 
-func sweepStacks(region string) error {
-	ctx := sweep.Context(region)
+func sweepStacks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -271,8 +266,7 @@ func sweepStacks(region string) error {
 	return sweep.SweepOrchestrator(ctx, sweepResources)
 }
 
-func sweepLayers(region string) error {
-	ctx := sweep.Context(region)
+func sweepLayers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -333,8 +327,7 @@ func sweepLayers(region string) error {
 	return sweep.SweepOrchestrator(ctx, sweepResources)
 }
 
-func sweepUserProfiles(region string) error {
-	ctx := sweep.Context(region)
+func sweepUserProfiles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,25 +7,24 @@
 package pipes
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/pipes"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_pipes_pipe", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_pipes_pipe", &sweep.Sweeper{
 		Name: "aws_pipes_pipe",
 		F:    sweepPipes,
 	})
 }
 
-func sweepPipes(region string) error {
-	ctx := sweep.Context(region)
+func sweepPipes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
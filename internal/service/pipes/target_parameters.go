@@ -916,8 +916,8 @@ func expandBatchArrayProperties(tfMap map[string]interface{}) *types.BatchArrayP
 
 	apiObject := &types.BatchArrayProperties{}
 
-	if v, ok := tfMap["size"].(int); ok {
-		apiObject.Size = int32(v)
+	if v, ok := tfMap["size"].(int); ok && v != 0 {
+		apiObject.Size = aws.Int32(int32(v))
 	}
 
 	return apiObject
@@ -1088,8 +1088,8 @@ func expandBatchRetryStrategy(tfMap map[string]interface{}) *types.BatchRetryStr
 
 	apiObject := &types.BatchRetryStrategy{}
 
-	if v, ok := tfMap["attempts"].(int); ok {
-		apiObject.Attempts = int32(v)
+	if v, ok := tfMap["attempts"].(int); ok && v != 0 {
+		apiObject.Attempts = aws.Int32(int32(v))
 	}
 
 	return apiObject
@@ -1514,8 +1514,8 @@ func expandECSEphemeralStorage(tfMap map[string]interface{}) *types.EcsEphemeral
 
 	apiObject := &types.EcsEphemeralStorage{}
 
-	if v, ok := tfMap["size_in_gib"].(int); ok {
-		apiObject.SizeInGiB = int32(v)
+	if v, ok := tfMap["size_in_gib"].(int); ok && v != 0 {
+		apiObject.SizeInGiB = aws.Int32(int32(v))
 	}
 
 	return apiObject
@@ -1960,7 +1960,7 @@ func flattenBatchArrayProperties(apiObject *types.BatchArrayProperties) map[stri
 
 	tfMap := map[string]interface{}{}
 
-	if v := apiObject.Size; v != 0 {
+	if v := aws.ToInt32(apiObject.Size); v != 0 {
 		tfMap["size"] = int(v)
 	}
 
@@ -2084,7 +2084,7 @@ func flattenBatchRetryStrategy(apiObject *types.BatchRetryStrategy) map[string]i
 
 	tfMap := map[string]interface{}{}
 
-	if v := apiObject.Attempts; v != 0 {
+	if v := aws.ToInt32(apiObject.Attempts); v != 0 {
 		tfMap["attempts"] = int(v)
 	}
 
@@ -7,19 +7,19 @@
 package qldb
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/qldb"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_qldb_ledger", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_qldb_ledger", &sweep.Sweeper{
 		Name: "aws_qldb_ledger",
 		F:    sweepLedgers,
 		Dependencies: []string{
@@ -27,15 +27,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_qldb_stream", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_qldb_stream", &sweep.Sweeper{
 		Name: "aws_qldb_stream",
 		F:    sweepStreams,
 	})
 
 }
 
-func sweepLedgers(region string) error {
-	ctx := sweep.Context(region)
+func sweepLedgers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -75,8 +74,7 @@ func sweepLedgers(region string) error {
 	return nil
 }
 
-func sweepStreams(region string) error {
-	ctx := sweep.Context(region)
+func sweepStreams(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -30,6 +30,7 @@ import (
 
 // Function annotations are used for resource registration to the Provider. DO NOT EDIT.
 // @FrameworkResource(name="Folder Membership")
+// @NoSweeper("deleted with aws_quicksight_folder")
 func newResourceFolderMembership(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceFolderMembership{}, nil
 }
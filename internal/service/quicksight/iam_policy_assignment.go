@@ -33,6 +33,7 @@ import (
 )
 
 // @FrameworkResource(name="IAM Policy Assignment")
+// @Sweeper(lister=listSweepableIAMPolicyAssignments)
 func newResourceIAMPolicyAssignment(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceIAMPolicyAssignment{}, nil
 }
@@ -29,6 +29,7 @@ import (
 )
 
 // @FrameworkResource(name="Ingestion")
+// @NoSweeper("ingestions cannot be deleted")
 func newResourceIngestion(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceIngestion{}, nil
 }
@@ -31,6 +31,7 @@ import (
 )
 
 // @FrameworkResource(name="Namespace")
+// @Sweeper(lister=listSweepableNamespaces, dependencies=aws_quicksight_iam_policy_assignment)
 // @Tags(identifierAttribute="arn")
 func newResourceNamespace(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceNamespace{}
@@ -35,6 +35,7 @@ import (
 )
 
 // @FrameworkResource(name="Refresh Schedule")
+// @NoSweeper("deleted with aws_quicksight_data_set")
 func newResourceRefreshSchedule(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceRefreshSchedule{}, nil
 }
@@ -7,6 +7,7 @@
 package quicksight
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,32 +15,33 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_quicksight_dashboard", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_dashboard", &sweep.Sweeper{
 		Name: "aws_quicksight_dashboard",
 		F:    sweepDashboards,
 	})
-	resource.AddTestSweepers("aws_quicksight_data_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_data_set", &sweep.Sweeper{
 		Name: "aws_quicksight_data_set",
 		F:    sweepDataSets,
 	})
-	resource.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_data_source", &sweep.Sweeper{
 		Name: "aws_quicksight_data_source",
 		F:    sweepDataSources,
 	})
-	resource.AddTestSweepers("aws_quicksight_folder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_folder", &sweep.Sweeper{
 		Name: "aws_quicksight_folder",
 		F:    sweepFolders,
 	})
-	resource.AddTestSweepers("aws_quicksight_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_template", &sweep.Sweeper{
 		Name: "aws_quicksight_template",
 		F:    sweepTemplates,
 	})
-	resource.AddTestSweepers("aws_quicksight_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_user", &sweep.Sweeper{
 		Name: "aws_quicksight_user",
 		F:    sweepUsers,
 	})
@@ -50,8 +52,7 @@ const (
 	acctestResourcePrefix = "tf-acc-test"
 )
 
-func sweepDashboards(region string) error {
-	ctx := sweep.Context(region)
+func sweepDashboards(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -102,8 +103,7 @@ func sweepDashboards(region string) error {
 	return nil
 }
 
-func sweepDataSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepDataSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -154,8 +154,7 @@ func sweepDataSets(region string) error {
 	return nil
 }
 
-func sweepDataSources(region string) error {
-	ctx := sweep.Context(region)
+func sweepDataSources(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -206,8 +205,7 @@ func sweepDataSources(region string) error {
 	return nil
 }
 
-func sweepFolders(region string) error {
-	ctx := sweep.Context(region)
+func sweepFolders(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -251,8 +249,7 @@ func sweepFolders(region string) error {
 
 }
 
-func sweepTemplates(region string) error {
-	ctx := sweep.Context(region)
+func sweepTemplates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -303,8 +300,7 @@ func sweepTemplates(region string) error {
 	return nil
 }
 
-func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
+func sweepUsers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -367,3 +363,159 @@ func skipSweepUserError(err error) bool {
 	return sweep.SkipSweepError(err)
 
 }
+
+func listSweepableNamespaces(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QuickSightConn(ctx)
+	awsAccountID := client.AccountID
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	namespaces, err := listNamespacesForSweep(ctx, conn, awsAccountID)
+
+	if skipSweepError(err) {
+		log.Printf("[WARN] Skipping QuickSight Namespace sweep for %s: %s", client.Region, err)
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, namespace := range namespaces {
+		sweepResources = append(sweepResources, framework.NewSweepResource(newResourceNamespace, client,
+			framework.NewAttribute("id", createNamespaceID(awsAccountID, namespace)),
+			framework.NewAttribute("aws_account_id", awsAccountID),
+			framework.NewAttribute("namespace", namespace),
+		))
+	}
+
+	return sweepResources, nil
+}
+
+func listSweepableIAMPolicyAssignments(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QuickSightConn(ctx)
+	awsAccountID := client.AccountID
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	namespaces, err := listNamespacesForSweep(ctx, conn, awsAccountID)
+
+	if skipSweepError(err) {
+		log.Printf("[WARN] Skipping QuickSight IAM Policy Assignment sweep for %s: %s", client.Region, err)
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// IAM policy assignments can also exist in the default namespace.
+	for _, namespace := range append(namespaces, DefaultUserNamespace) {
+		input := &quicksight.ListIAMPolicyAssignmentsInput{
+			AwsAccountId: aws.String(awsAccountID),
+			Namespace:    aws.String(namespace),
+		}
+
+		err := conn.ListIAMPolicyAssignmentsPagesWithContext(ctx, input, func(page *quicksight.ListIAMPolicyAssignmentsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.IAMPolicyAssignments {
+				if v == nil {
+					continue
+				}
+
+				assignmentName := aws.StringValue(v.AssignmentName)
+
+				sweepResources = append(sweepResources, framework.NewSweepResource(newResourceIAMPolicyAssignment, client,
+					framework.NewAttribute("id", createIAMPolicyAssignmentID(awsAccountID, namespace, assignmentName)),
+					framework.NewAttribute("aws_account_id", awsAccountID),
+					framework.NewAttribute("namespace", namespace),
+					framework.NewAttribute("assignment_name", assignmentName),
+				))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("listing QuickSight IAM Policy Assignments (%s): %w", namespace, err)
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func listSweepableVPCConnections(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QuickSightConn(ctx)
+	awsAccountID := client.AccountID
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	input := &quicksight.ListVPCConnectionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+	}
+
+	err := conn.ListVPCConnectionsPagesWithContext(ctx, input, func(page *quicksight.ListVPCConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VPCConnectionSummaries {
+			if v == nil || aws.StringValue(v.Status) == quicksight.VPCConnectionResourceStatusDeleted {
+				continue
+			}
+
+			vpcConnectionID := aws.StringValue(v.VPCConnectionId)
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceVPCConnection, client,
+				framework.NewAttribute("id", createVPCConnectionID(awsAccountID, vpcConnectionID)),
+				framework.NewAttribute("aws_account_id", awsAccountID),
+				framework.NewAttribute("vpc_connection_id", vpcConnectionID),
+			))
+		}
+
+		return !lastPage
+	})
+
+	if skipSweepError(err) {
+		log.Printf("[WARN] Skipping QuickSight VPC Connection sweep for %s: %s", client.Region, err)
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+// listNamespacesForSweep returns the names of the account's namespaces, other than the default namespace.
+func listNamespacesForSweep(ctx context.Context, conn *quicksight.QuickSight, awsAccountID string) ([]string, error) {
+	input := &quicksight.ListNamespacesInput{
+		AwsAccountId: aws.String(awsAccountID),
+	}
+	namespaces := make([]string, 0)
+
+	err := conn.ListNamespacesPagesWithContext(ctx, input, func(page *quicksight.ListNamespacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Namespaces {
+			if v == nil {
+				continue
+			}
+
+			if name := aws.StringValue(v.Name); name != DefaultUserNamespace {
+				namespaces = append(namespaces, name)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return namespaces, nil
+}
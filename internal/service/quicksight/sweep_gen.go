@@ -0,0 +1,20 @@
+// Code generated by internal/generate/servicepackage/main.go; DO NOT EDIT.
+
+//go:build sweep
+// +build sweep
+
+package quicksight
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	framework.Exclude(newResourceFolderMembership, "deleted with aws_quicksight_folder")
+	framework.Register(newResourceIAMPolicyAssignment, listSweepableIAMPolicyAssignments)
+	framework.Exclude(newResourceIngestion, "ingestions cannot be deleted")
+	framework.Register(newResourceNamespace, listSweepableNamespaces, "aws_quicksight_iam_policy_assignment")
+	framework.Exclude(newResourceRefreshSchedule, "deleted with aws_quicksight_data_set")
+	framework.Exclude(newResourceTemplateAlias, "deleted with aws_quicksight_template")
+	framework.Register(newResourceVPCConnection, listSweepableVPCConnections)
+}
@@ -27,6 +27,7 @@ import (
 )
 
 // @FrameworkResource(name="Template Alias")
+// @NoSweeper("deleted with aws_quicksight_template")
 func newResourceTemplateAlias(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceTemplateAlias{}, nil
 }
@@ -34,6 +34,7 @@ import (
 )
 
 // @FrameworkResource(name="VPC Connection")
+// @Sweeper(lister=listSweepableVPCConnections)
 // @Tags(identifierAttribute="arn")
 func newResourceVPCConnection(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceVPCConnection{}
@@ -7,24 +7,23 @@
 package ram
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ram"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ram_resource_share", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ram_resource_share", &sweep.Sweeper{
 		Name: "aws_ram_resource_share",
 		F:    sweepResourceShares,
 	})
 }
 
-func sweepResourceShares(region string) error {
-	ctx := sweep.Context(region)
+func sweepResourceShares(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,6 +7,7 @@
 package redshift
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,12 +15,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_cluster_snapshot", &sweep.Sweeper{
 		Name: "aws_redshift_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -27,42 +27,42 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_redshift_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_cluster", &sweep.Sweeper{
 		Name: "aws_redshift_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_redshift_hsm_client_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_hsm_client_certificate", &sweep.Sweeper{
 		Name: "aws_redshift_hsm_client_certificate",
 		F:    sweepHSMClientCertificates,
 	})
 
-	resource.AddTestSweepers("aws_redshift_hsm_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_hsm_configuration", &sweep.Sweeper{
 		Name: "aws_redshift_hsm_configuration",
 		F:    sweepHSMConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_redshift_authentication_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_authentication_profile", &sweep.Sweeper{
 		Name: "aws_redshift_authentication_profile",
 		F:    sweepAuthenticationProfiles,
 	})
 
-	resource.AddTestSweepers("aws_redshift_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_event_subscription", &sweep.Sweeper{
 		Name: "aws_redshift_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_redshift_scheduled_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_scheduled_action", &sweep.Sweeper{
 		Name: "aws_redshift_scheduled_action",
 		F:    sweepScheduledActions,
 	})
 
-	resource.AddTestSweepers("aws_redshift_snapshot_schedule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_snapshot_schedule", &sweep.Sweeper{
 		Name: "aws_redshift_snapshot_schedule",
 		F:    sweepSnapshotSchedules,
 	})
 
-	resource.AddTestSweepers("aws_redshift_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_subnet_group", &sweep.Sweeper{
 		Name: "aws_redshift_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -71,8 +71,7 @@ func init() {
 	})
 }
 
-func sweepClusterSnapshots(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusterSnapshots(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -115,8 +114,7 @@ func sweepClusterSnapshots(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -162,8 +160,7 @@ func sweepClusters(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepEventSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepEventSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -206,8 +203,7 @@ func sweepEventSubscriptions(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepScheduledActions(region string) error {
-	ctx := sweep.Context(region)
+func sweepScheduledActions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -250,8 +246,7 @@ func sweepScheduledActions(region string) error {
 	return nil
 }
 
-func sweepSnapshotSchedules(region string) error {
-	ctx := sweep.Context(region)
+func sweepSnapshotSchedules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -305,8 +300,7 @@ func sweepSnapshotSchedules(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepSubnetGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -361,8 +355,7 @@ func sweepSubnetGroups(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepHSMClientCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepHSMClientCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -407,8 +400,7 @@ func sweepHSMClientCertificates(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepHSMConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepHSMConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -453,8 +445,7 @@ func sweepHSMConfigurations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepAuthenticationProfiles(region string) error {
-	ctx := sweep.Context(region)
+func sweepAuthenticationProfiles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -7,26 +7,29 @@
 package resourceexplorer2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_resourceexplorer2_index", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_resourceexplorer2_index", &sweep.Sweeper{
 		Name: "aws_resourceexplorer2_index",
 		F:    sweepIndexes,
+		Dependencies: []string{
+			"aws_resourceexplorer2_view",
+		},
 	})
 }
 
-func sweepIndexes(region string) error {
-	ctx := sweep.Context(region)
+func sweepIndexes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -63,3 +66,26 @@ func sweepIndexes(region string) error {
 
 	return nil
 }
+
+func listSweepableViews(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.ResourceExplorer2Client(ctx)
+	input := &resourceexplorer2.ListViewsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := resourceexplorer2.NewListViewsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Views {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceView, client,
+				framework.NewAttribute("id", v),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,14 @@
+// Code generated by internal/generate/servicepackage/main.go; DO NOT EDIT.
+
+//go:build sweep
+// +build sweep
+
+package resourceexplorer2
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	framework.Register(newResourceView, listSweepableViews)
+}
@@ -38,6 +38,7 @@ import (
 )
 
 // @FrameworkResource(name="View")
+// @Sweeper(lister=listSweepableViews)
 // @Tags(identifierAttribute="id")
 func newResourceView(context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceView{}, nil
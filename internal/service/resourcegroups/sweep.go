@@ -7,24 +7,23 @@
 package resourcegroups
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_resourcegroups_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_resourcegroups_group", &sweep.Sweeper{
 		Name: "aws_resourcegroups_group",
 		F:    sweepGroups,
 	})
 }
 
-func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
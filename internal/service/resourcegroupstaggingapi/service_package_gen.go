@@ -33,7 +33,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceTags,
+			TypeName: "aws_resourcegroupstaggingapi_tags",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// See https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_TagResources.html.
+	tagResourcesARNBatchSize = 20
+	tagResourcesTagBatchSize = 50
+	// See https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_GetResources.html.
+	getResourcesARNBatchSize = 100
+)
+
+// @SDKResource("aws_resourcegroupstaggingapi_tags")
+func ResourceTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTagsCreate,
+		ReadWithoutTimeout:   resourceTagsRead,
+		UpdateWithoutTimeout: resourceTagsUpdate,
+		DeleteWithoutTimeout: resourceTagsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: verify.ValidAllDiag(
+					validation.MapKeyLenBetween(1, 128),
+					validation.MapValueLenBetween(0, 256),
+				),
+			},
+		},
+	}
+}
+
+func resourceTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+	arns := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	tags := tftags.New(ctx, d.Get("tags").(map[string]interface{}))
+
+	d.SetId(id.UniqueId())
+
+	if err := tagResources(ctx, conn, arns, tags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+	arns := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	tags := tftags.New(ctx, d.Get("tags").(map[string]interface{}))
+
+	resourceTags, err := findResourceTagsByARNs(ctx, conn, arns)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	// Only resources that still carry every owned tag with its configured value are kept in state.
+	// Resources that have drifted (or are no longer returned) are re-tagged on the next apply.
+	var inSync []string
+	for _, arn := range arns {
+		if v, ok := resourceTags[arn]; ok && v.ContainsAll(tags) {
+			inSync = append(inSync, arn)
+		}
+	}
+
+	d.Set("resource_arns", inSync)
+
+	return diags
+}
+
+func resourceTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+	o, n := d.GetChange("resource_arns")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	removedARNs := flex.ExpandStringValueSet(os.Difference(ns))
+	addedARNs := flex.ExpandStringValueSet(ns.Difference(os))
+	keptARNs := flex.ExpandStringValueSet(os.Intersection(ns))
+
+	o, n = d.GetChange("tags")
+	oldTags := tftags.New(ctx, o.(map[string]interface{}))
+	newTags := tftags.New(ctx, n.(map[string]interface{}))
+
+	if err := untagResources(ctx, conn, removedARNs, oldTags.Keys(), false); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Tags (%s): removing tags: %s", d.Id(), err)
+	}
+
+	if err := untagResources(ctx, conn, keptARNs, oldTags.Removed(newTags).Keys(), false); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Tags (%s): removing tags: %s", d.Id(), err)
+	}
+
+	if err := tagResources(ctx, conn, keptARNs, oldTags.Updated(newTags)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Tags (%s): adding tags: %s", d.Id(), err)
+	}
+
+	if err := tagResources(ctx, conn, addedARNs, newTags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Tags (%s): adding tags: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+	arns := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	tags := tftags.New(ctx, d.Get("tags").(map[string]interface{}))
+
+	if err := untagResources(ctx, conn, arns, tags.Keys(), true); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// tagResources adds the specified tags to each of the specified resources, batching requests to stay within API limits.
+func tagResources(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, arns []string, tags tftags.KeyValueTags) error {
+	if len(arns) == 0 || len(tags) == 0 {
+		return nil
+	}
+
+	var errs []error
+
+	for _, arns := range tfslices.Chunks(arns, tagResourcesARNBatchSize) {
+		for _, tags := range tags.Chunks(tagResourcesTagBatchSize) {
+			input := &resourcegroupstaggingapi.TagResourcesInput{
+				ResourceARNList: aws.StringSlice(arns),
+				Tags:            aws.StringMap(tags.Map()),
+			}
+
+			output, err := conn.TagResourcesWithContext(ctx, input)
+
+			if err != nil {
+				return err
+			}
+
+			errs = append(errs, failedResourcesError(output.FailedResourcesMap, false))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// untagResources removes the specified tag keys from each of the specified resources, batching requests to stay within API limits.
+// If ignoreNotFound is set, failures for resources that no longer exist are not reported.
+func untagResources(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, arns []string, keys []string, ignoreNotFound bool) error {
+	if len(arns) == 0 || len(keys) == 0 {
+		return nil
+	}
+
+	var errs []error
+
+	for _, arns := range tfslices.Chunks(arns, tagResourcesARNBatchSize) {
+		for _, keys := range tfslices.Chunks(keys, tagResourcesTagBatchSize) {
+			input := &resourcegroupstaggingapi.UntagResourcesInput{
+				ResourceARNList: aws.StringSlice(arns),
+				TagKeys:         aws.StringSlice(keys),
+			}
+
+			output, err := conn.UntagResourcesWithContext(ctx, input)
+
+			if err != nil {
+				return err
+			}
+
+			errs = append(errs, failedResourcesError(output.FailedResourcesMap, ignoreNotFound))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// findResourceTagsByARNs returns the current tags of each of the specified resources.
+// Resources that have never been tagged, or that no longer exist, are not returned.
+func findResourceTagsByARNs(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, arns []string) (map[string]tftags.KeyValueTags, error) {
+	output := make(map[string]tftags.KeyValueTags)
+
+	for _, arns := range tfslices.Chunks(arns, getResourcesARNBatchSize) {
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: aws.StringSlice(arns),
+		}
+
+		err := conn.GetResourcesPagesWithContext(ctx, input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				if v != nil {
+					output[aws.StringValue(v.ResourceARN)] = KeyValueTags(ctx, v.Tags)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func failedResourcesError(apiObjects map[string]*resourcegroupstaggingapi.FailureInfo, ignoreNotFound bool) error {
+	arns := make([]string, 0, len(apiObjects))
+	for arn := range apiObjects {
+		arns = append(arns, arn)
+	}
+	sort.Strings(arns)
+
+	var errs []error
+
+	for _, arn := range arns {
+		apiObject := apiObjects[arn]
+
+		if apiObject == nil {
+			continue
+		}
+
+		if ignoreNotFound && aws.Int64Value(apiObject.StatusCode) == http.StatusNotFound {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", arn, awserr.New(aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage), nil)))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+)
+
+func TestAccResourceGroupsTaggingAPITags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsConfig_basic(rName, 2, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_vpc.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_vpc.test.1", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.tf-acc-bulk-key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPITags_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsConfig_basic(rName, 1, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.tf-acc-bulk-key1", "value1"),
+				),
+			},
+			{
+				Config: testAccTagsConfig_updated(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.tf-acc-bulk-key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.tf-acc-bulk-key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTagsConfig_basic(rName, 2, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagsExist(ctx, resourceName),
+					testAccCheckTagsRemoved(ctx, "aws_vpc.test.2", "tf-acc-bulk-key1", "tf-acc-bulk-key2"),
+					testAccCheckTagsRemoved(ctx, "aws_vpc.test.0", "tf-acc-bulk-key2"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.tf-acc-bulk-key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPITags_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsConfig_basic(rName, 2, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagsExist(ctx, resourceName),
+					testAccCheckTagsUntagOutOfBand(ctx, "aws_vpc.test.1", "tf-acc-bulk-key1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTagsConfig_basic(rName, 2, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTagsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resourcegroupstaggingapi_tags" {
+				continue
+			}
+
+			arns, tags := testAccTagsFromState(rs)
+
+			output, err := tfresourcegroupstaggingapi.FindResourceTagsByARNs(ctx, conn, arns)
+
+			if err != nil {
+				return err
+			}
+
+			for arn, v := range output {
+				for key := range tags {
+					if _, ok := v[key]; ok {
+						return fmt.Errorf("Resource Groups Tagging API Tags %s still exists on %s", key, arn)
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTagsExist(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+		arns, tags := testAccTagsFromState(rs)
+
+		output, err := tfresourcegroupstaggingapi.FindResourceTagsByARNs(ctx, conn, arns)
+
+		if err != nil {
+			return err
+		}
+
+		for _, arn := range arns {
+			for key, value := range tags {
+				if v, ok := output[arn][key]; !ok || v == nil || v.ValueString() != value {
+					return fmt.Errorf("Resource Groups Tagging API Tag %s=%s not found on %s", key, value, arn)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTagsRemoved(ctx context.Context, n string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+		arn := rs.Primary.Attributes["arn"]
+		output, err := tfresourcegroupstaggingapi.FindResourceTagsByARNs(ctx, conn, []string{arn})
+
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if _, ok := output[arn][key]; ok {
+				return fmt.Errorf("Resource Groups Tagging API Tag %s still exists on %s", key, arn)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTagsUntagOutOfBand(ctx context.Context, n string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+		return tfresourcegroupstaggingapi.UntagResources(ctx, conn, []string{rs.Primary.Attributes["arn"]}, keys, false)
+	}
+}
+
+func testAccTagsFromState(rs *terraform.ResourceState) ([]string, map[string]string) {
+	var arns []string
+	tags := make(map[string]string)
+
+	for k, v := range rs.Primary.Attributes {
+		if k == "resource_arns.#" || k == "tags.%" {
+			continue
+		}
+
+		if _, ok := strings.CutPrefix(k, "resource_arns."); ok {
+			arns = append(arns, v)
+		} else if key, ok := strings.CutPrefix(k, "tags."); ok {
+			tags[key] = v
+		}
+	}
+
+	return arns, tags
+}
+
+func testAccTagsConfig_base(rName string, count int) string {
+	// The VPCs must not remove the tags managed by the aws_resourcegroupstaggingapi_tags resource.
+	return acctest.ConfigCompose(acctest.ConfigIgnoreTagsKeyPrefixes1("tf-acc-bulk-"), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = %[2]d
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, count))
+}
+
+func testAccTagsConfig_basic(rName string, count int, value1 string) string {
+	return acctest.ConfigCompose(testAccTagsConfig_base(rName, 3), fmt.Sprintf(`
+resource "aws_resourcegroupstaggingapi_tags" "test" {
+  resource_arns = slice(aws_vpc.test[*].arn, 0, %[1]d)
+
+  tags = {
+    "tf-acc-bulk-key1" = %[2]q
+  }
+}
+`, count, value1))
+}
+
+func testAccTagsConfig_updated(rName string, count int) string {
+	return acctest.ConfigCompose(testAccTagsConfig_base(rName, 3), fmt.Sprintf(`
+resource "aws_resourcegroupstaggingapi_tags" "test" {
+  resource_arns = slice(aws_vpc.test[*].arn, 0, %[1]d)
+
+  tags = {
+    "tf-acc-bulk-key1" = "value1updated"
+    "tf-acc-bulk-key2" = "value2"
+  }
+}
+`, count))
+}
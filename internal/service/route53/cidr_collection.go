@@ -29,6 +29,7 @@ import (
 )
 
 // @FrameworkResource
+// @Sweeper(lister=listSweepableCIDRCollections, dependencies=aws_route53_cidr_location)
 func newResourceCIDRCollection(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceCIDRCollection{}
 
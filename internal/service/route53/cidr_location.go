@@ -31,6 +31,7 @@ import (
 )
 
 // @FrameworkResource
+// @Sweeper(lister=listSweepableCIDRLocations)
 func newResourceCIDRLocation(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceCIDRLocation{}
 
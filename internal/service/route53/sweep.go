@@ -7,6 +7,7 @@
 package route53
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -16,28 +17,29 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_health_check", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_health_check", &sweep.Sweeper{
 		Name: "aws_route53_health_check",
 		F:    sweepHealthChecks,
 	})
 
-	resource.AddTestSweepers("aws_route53_key_signing_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_key_signing_key", &sweep.Sweeper{
 		Name: "aws_route53_key_signing_key",
 		F:    sweepKeySigningKeys,
 	})
 
-	resource.AddTestSweepers("aws_route53_query_log", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_query_log", &sweep.Sweeper{
 		Name: "aws_route53_query_log",
 		F:    sweepQueryLogs,
 	})
 
-	resource.AddTestSweepers("aws_route53_traffic_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_traffic_policy", &sweep.Sweeper{
 		Name: "aws_route53_traffic_policy",
 		F:    sweepTrafficPolicies,
 		Dependencies: []string{
@@ -45,12 +47,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_traffic_policy_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_traffic_policy_instance", &sweep.Sweeper{
 		Name: "aws_route53_traffic_policy_instance",
 		F:    sweepTrafficPolicyInstances,
 	})
 
-	resource.AddTestSweepers("aws_route53_zone", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_zone", &sweep.Sweeper{
 		Name: "aws_route53_zone",
 		Dependencies: []string{
 			"aws_service_discovery_http_namespace",
@@ -64,8 +66,7 @@ func init() {
 	})
 }
 
-func sweepHealthChecks(region string) error {
-	ctx := sweep.Context(region)
+func sweepHealthChecks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -116,8 +117,7 @@ func sweepHealthChecks(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepKeySigningKeys(region string) error {
-	ctx := sweep.Context(region)
+func sweepKeySigningKeys(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -196,8 +196,7 @@ func sweepKeySigningKeys(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepQueryLogs(region string) error {
-	ctx := sweep.Context(region)
+func sweepQueryLogs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -241,8 +240,7 @@ func sweepQueryLogs(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepTrafficPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -285,8 +283,7 @@ func sweepTrafficPolicies(region string) error {
 	return nil
 }
 
-func sweepTrafficPolicyInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficPolicyInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -329,8 +326,7 @@ func sweepTrafficPolicyInstances(region string) error {
 	return nil
 }
 
-func sweepZones(region string) error {
-	ctx := sweep.Context(region)
+func sweepZones(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -400,3 +396,111 @@ func hostedZonesToPreserve() []string {
 		"terraform-provider-aws-acctest-acm.com",
 	}
 }
+
+func listSweepableCIDRCollections(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.Route53Conn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	collectionIDs, err := listCIDRCollectionIDsForSweep(ctx, conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range collectionIDs {
+		sweepResources = append(sweepResources, framework.NewSweepResource(newResourceCIDRCollection, client,
+			framework.NewAttribute("id", id),
+		))
+	}
+
+	return sweepResources, nil
+}
+
+func listSweepableCIDRLocations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.Route53Conn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	collectionIDs, err := listCIDRCollectionIDsForSweep(ctx, conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, collectionID := range collectionIDs {
+		input := &route53.ListCidrLocationsInput{
+			CollectionId: aws.String(collectionID),
+		}
+		var locationNames []string
+
+		err := conn.ListCidrLocationsPagesWithContext(ctx, input, func(page *route53.ListCidrLocationsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.CidrLocations {
+				if v == nil {
+					continue
+				}
+
+				locationNames = append(locationNames, aws.StringValue(v.LocationName))
+			}
+
+			return !lastPage
+		})
+
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchCidrCollectionException) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("listing Route 53 CIDR Locations (%s): %w", collectionID, err)
+		}
+
+		for _, name := range locationNames {
+			cidrBlocks, err := findCIDRLocationByTwoPartKey(ctx, conn, collectionID, name)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				log.Printf("[WARN] %s", err)
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceCIDRLocation, client,
+				framework.NewAttribute("id", cidrLocationCreateResourceID(collectionID, name)),
+				framework.NewAttribute("cidr_blocks", cidrBlocks),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func listCIDRCollectionIDsForSweep(ctx context.Context, conn *route53.Route53) ([]string, error) {
+	input := &route53.ListCidrCollectionsInput{}
+	ids := make([]string, 0)
+
+	err := conn.ListCidrCollectionsPagesWithContext(ctx, input, func(page *route53.ListCidrCollectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CidrCollections {
+			if v == nil {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(v.Id))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,15 @@
+// Code generated by internal/generate/servicepackage/main.go; DO NOT EDIT.
+
+//go:build sweep
+// +build sweep
+
+package route53
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	framework.Register(newResourceCIDRCollection, listSweepableCIDRCollections, "aws_route53_cidr_location")
+	framework.Register(newResourceCIDRLocation, listSweepableCIDRLocations)
+}
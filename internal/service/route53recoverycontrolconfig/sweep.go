@@ -7,18 +7,18 @@
 package route53recoverycontrolconfig
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53recoverycontrolconfig_cluster", &sweep.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_control_panel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53recoverycontrolconfig_control_panel", &sweep.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_control_panel",
 		F:    sweepControlPanels,
 		Dependencies: []string{
@@ -35,19 +35,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_routing_control", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53recoverycontrolconfig_routing_control", &sweep.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_routing_control",
 		F:    sweepRoutingControls,
 	})
 
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_safety_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53recoverycontrolconfig_safety_rule", &sweep.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_safety_rule",
 		F:    sweepSafetyRules,
 	})
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -90,8 +89,7 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepControlPanels(region string) error {
-	ctx := sweep.Context(region)
+func sweepControlPanels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -161,8 +159,7 @@ func sweepControlPanels(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRoutingControls(region string) error {
-	ctx := sweep.Context(region)
+func sweepRoutingControls(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -250,8 +247,7 @@ func sweepRoutingControls(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSafetyRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepSafetyRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -7,23 +7,23 @@
 package route53resolver
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_dnssec_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_dnssec_config", &sweep.Sweeper{
 		Name: "aws_route53_resolver_dnssec_config",
 		F:    sweepDNSSECConfig,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_endpoint", &sweep.Sweeper{
 		Name: "aws_route53_resolver_endpoint",
 		F:    sweepEndpoints,
 		Dependencies: []string{
@@ -31,12 +31,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_config", &sweep.Sweeper{
 		Name: "aws_route53_resolver_firewall_config",
 		F:    sweepFirewallConfigs,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_domain_list", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_domain_list", &sweep.Sweeper{
 		Name: "aws_route53_resolver_firewall_domain_list",
 		F:    sweepFirewallDomainLists,
 		Dependencies: []string{
@@ -44,12 +44,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule_group_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_rule_group_association", &sweep.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule_group_association",
 		F:    sweepFirewallRuleGroupAssociations,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_rule_group", &sweep.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule_group",
 		F:    sweepFirewallRuleGroups,
 		Dependencies: []string{
@@ -58,7 +58,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_rule", &sweep.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule",
 		F:    sweepFirewallRules,
 		Dependencies: []string{
@@ -66,12 +66,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_query_log_config_association", &sweep.Sweeper{
 		Name: "aws_route53_resolver_query_log_config_association",
 		F:    sweepQueryLogConfigAssociations,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_query_log_config", &sweep.Sweeper{
 		Name: "aws_route53_resolver_query_log_config",
 		F:    sweepQueryLogsConfig,
 		Dependencies: []string{
@@ -79,12 +79,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_rule_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_rule_association", &sweep.Sweeper{
 		Name: "aws_route53_resolver_rule_association",
 		F:    sweepRuleAssociations,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_rule", &sweep.Sweeper{
 		Name: "aws_route53_resolver_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -93,8 +93,7 @@ func init() {
 	})
 }
 
-func sweepDNSSECConfig(region string) error {
-	ctx := sweep.Context(region)
+func sweepDNSSECConfig(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -138,8 +137,7 @@ func sweepDNSSECConfig(region string) error {
 	return nil
 }
 
-func sweepEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -182,8 +180,7 @@ func sweepEndpoints(region string) error {
 	return nil
 }
 
-func sweepFirewallConfigs(region string) error {
-	ctx := sweep.Context(region)
+func sweepFirewallConfigs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -227,8 +224,7 @@ func sweepFirewallConfigs(region string) error {
 	return nil
 }
 
-func sweepFirewallDomainLists(region string) error {
-	ctx := sweep.Context(region)
+func sweepFirewallDomainLists(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -271,8 +267,7 @@ func sweepFirewallDomainLists(region string) error {
 	return nil
 }
 
-func sweepFirewallRuleGroupAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepFirewallRuleGroupAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -315,8 +310,7 @@ func sweepFirewallRuleGroupAssociations(region string) error {
 	return nil
 }
 
-func sweepFirewallRuleGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepFirewallRuleGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -359,8 +353,7 @@ func sweepFirewallRuleGroups(region string) error {
 	return nil
 }
 
-func sweepFirewallRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepFirewallRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -426,8 +419,7 @@ func sweepFirewallRules(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepQueryLogConfigAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepQueryLogConfigAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -472,8 +464,7 @@ func sweepQueryLogConfigAssociations(region string) error {
 	return nil
 }
 
-func sweepQueryLogsConfig(region string) error {
-	ctx := sweep.Context(region)
+func sweepQueryLogsConfig(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -516,8 +507,7 @@ func sweepQueryLogsConfig(region string) error {
 	return nil
 }
 
-func sweepRuleAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepRuleAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -562,8 +552,7 @@ func sweepRuleAssociations(region string) error {
 	return nil
 }
 
-func sweepRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1436,6 +1436,36 @@ func FindBucket(ctx context.Context, conn *s3.S3, bucket string) error {
 	return err
 }
 
+func FindBuckets(ctx context.Context, conn *s3.S3, namePrefix string) ([]*s3.Bucket, error) {
+	input := &s3.ListBucketsInput{}
+
+	output, err := conn.ListBucketsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var results []*s3.Bucket
+
+	for _, bucket := range output.Buckets {
+		if bucket == nil {
+			continue
+		}
+
+		if namePrefix != "" && !strings.HasPrefix(aws.StringValue(bucket.Name), namePrefix) {
+			continue
+		}
+
+		results = append(results, bucket)
+	}
+
+	return results, nil
+}
+
 // https://docs.aws.amazon.com/general/latest/gr/rande.html#s3_region
 func BucketRegionalDomainName(bucket string, region string) (string, error) {
 	// Return a default AWS Commercial domain name if no region is provided
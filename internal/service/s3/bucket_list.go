@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// listBuckets lists existing S3 buckets owned by the caller.
+// The supported filter is `name_prefix`.
+//
+// @ListResource("aws_s3_bucket", name="Bucket")
+func listBuckets(ctx context.Context, meta any, filters map[string]string) ([]types.ListResult, error) {
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	var namePrefix string
+
+	for k, v := range filters {
+		switch k {
+		case "name_prefix":
+			namePrefix = v
+		default:
+			return nil, fmt.Errorf("listing S3 Buckets: unsupported filter: %s", k)
+		}
+	}
+
+	buckets, err := FindBuckets(ctx, conn, namePrefix)
+
+	if err != nil {
+		return nil, fmt.Errorf("listing S3 Buckets: %w", err)
+	}
+
+	var results []types.ListResult
+
+	for _, bucket := range buckets {
+		name := aws.StringValue(bucket.Name)
+
+		results = append(results, types.ListResult{
+			DisplayName: name,
+			ImportID:    name,
+		})
+	}
+
+	return results, nil
+}
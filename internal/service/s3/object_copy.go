@@ -469,7 +469,7 @@ func resourceObjectCopyDelete(ctx context.Context, d *schema.ResourceData, meta
 func resourceObjectCopyDoCopy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Conn(ctx)
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := tftags.DefaultConfigFromContext(ctx, meta.(*conns.AWSClient).DefaultTagsConfig)
 	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	input := &s3.CopyObjectInput{
@@ -20,18 +20,17 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_object", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_object", &sweep.Sweeper{
 		Name: "aws_s3_object",
 		F:    sweepObjects,
 	})
 
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket", &sweep.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    sweepBuckets,
 		Dependencies: []string{
@@ -42,8 +41,7 @@ func init() {
 	})
 }
 
-func sweepObjects(region string) error {
-	ctx := sweep.Context(region)
+func sweepObjects(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -117,8 +115,7 @@ func (os objectSweeper) Delete(ctx context.Context, timeout time.Duration, optFn
 	return nil
 }
 
-func sweepBuckets(region string) error {
-	ctx := sweep.Context(region)
+func sweepBuckets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -7,6 +7,7 @@
 package s3control
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,12 +15,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_access_point", &sweep.Sweeper{
 		Name: "aws_s3_access_point",
 		F:    sweepAccessPoints,
 		Dependencies: []string{
@@ -27,24 +27,23 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3control_multi_region_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_multi_region_access_point", &sweep.Sweeper{
 		Name: "aws_s3control_multi_region_access_point",
 		F:    sweepMultiRegionAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_s3control_object_lambda_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_object_lambda_access_point", &sweep.Sweeper{
 		Name: "aws_s3control_object_lambda_access_point",
 		F:    sweepObjectLambdaAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_s3control_storage_lens_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_storage_lens_configuration", &sweep.Sweeper{
 		Name: "aws_s3control_storage_lens_configuration",
 		F:    sweepStorageLensConfigurations,
 	})
 }
 
-func sweepAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccessPoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -96,8 +95,7 @@ func sweepAccessPoints(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepMultiRegionAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepMultiRegionAccessPoints(ctx context.Context, region string) error {
 	if region != endpoints.UsWest2RegionID {
 		log.Printf("[WARN] Skipping S3 Multi-Region Access Point sweep for region: %s", region)
 		return nil
@@ -148,8 +146,7 @@ func sweepMultiRegionAccessPoints(region string) error {
 	return nil
 }
 
-func sweepObjectLambdaAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepObjectLambdaAccessPoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -195,8 +192,7 @@ func sweepObjectLambdaAccessPoints(region string) error {
 	return nil
 }
 
-func sweepStorageLensConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepStorageLensConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
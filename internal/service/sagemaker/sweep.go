@@ -7,6 +7,7 @@
 package sagemaker
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,32 +15,31 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_app_image_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_app_image_config", &sweep.Sweeper{
 		Name: "aws_sagemaker_app_image_config",
 		F:    sweepAppImagesConfig,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_app", &sweep.Sweeper{
 		Name: "aws_sagemaker_app",
 		F:    sweepApps,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_code_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_code_repository", &sweep.Sweeper{
 		Name: "aws_sagemaker_code_repository",
 		F:    sweepCodeRepositories,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_device_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_device_fleet", &sweep.Sweeper{
 		Name: "aws_sagemaker_device_fleet",
 		F:    sweepDeviceFleets,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_domain", &sweep.Sweeper{
 		Name: "aws_sagemaker_domain",
 		F:    sweepDomains,
 		Dependencies: []string{
@@ -50,7 +50,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_endpoint_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_endpoint_configuration", &sweep.Sweeper{
 		Name: "aws_sagemaker_endpoint_configuration",
 		Dependencies: []string{
 			"aws_sagemaker_model",
@@ -58,7 +58,7 @@ func init() {
 		F: sweepEndpointConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_endpoint", &sweep.Sweeper{
 		Name: "aws_sagemaker_endpoint",
 		Dependencies: []string{
 			"aws_sagemaker_model",
@@ -67,37 +67,37 @@ func init() {
 		F: sweepEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_feature_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_feature_group", &sweep.Sweeper{
 		Name: "aws_sagemaker_feature_group",
 		F:    sweepFeatureGroups,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_flow_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_flow_definition", &sweep.Sweeper{
 		Name: "aws_sagemaker_flow_definition",
 		F:    sweepFlowDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_human_task_ui", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_human_task_ui", &sweep.Sweeper{
 		Name: "aws_sagemaker_human_task_ui",
 		F:    sweepHumanTaskUIs,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_image", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_image", &sweep.Sweeper{
 		Name: "aws_sagemaker_image",
 		F:    sweepImages,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_model_package_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_model_package_group", &sweep.Sweeper{
 		Name: "aws_sagemaker_model_package_group",
 		F:    sweepModelPackageGroups,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_model", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_model", &sweep.Sweeper{
 		Name: "aws_sagemaker_model",
 		F:    sweepModels,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance_lifecycle_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_notebook_instance_lifecycle_configuration", &sweep.Sweeper{
 		Name: "aws_sagemaker_notebook_instance_lifecycle_configuration",
 		F:    sweepNotebookInstanceLifecycleConfiguration,
 		Dependencies: []string{
@@ -105,12 +105,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_notebook_instance", &sweep.Sweeper{
 		Name: "aws_sagemaker_notebook_instance",
 		F:    sweepNotebookInstances,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_studio_lifecycle_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_studio_lifecycle_config", &sweep.Sweeper{
 		Name: "aws_sagemaker_studio_lifecycle_config",
 		F:    sweepStudioLifecyclesConfig,
 		Dependencies: []string{
@@ -118,7 +118,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_space", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_space", &sweep.Sweeper{
 		Name: "aws_sagemaker_space",
 		F:    sweepUserProfiles,
 		Dependencies: []string{
@@ -126,7 +126,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_user_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_user_profile", &sweep.Sweeper{
 		Name: "aws_sagemaker_user_profile",
 		F:    sweepUserProfiles,
 		Dependencies: []string{
@@ -134,7 +134,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_workforce", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_workforce", &sweep.Sweeper{
 		Name: "aws_sagemaker_workforce",
 		F:    sweepWorkforces,
 		Dependencies: []string{
@@ -142,24 +142,23 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_workteam", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_workteam", &sweep.Sweeper{
 		Name: "aws_sagemaker_workteam",
 		F:    sweepWorkteams,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_project", &sweep.Sweeper{
 		Name: "aws_sagemaker_project",
 		F:    sweepProjects,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_pipeline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_pipeline", &sweep.Sweeper{
 		Name: "aws_sagemaker_pipeline",
 		F:    sweepPipelines,
 	})
 }
 
-func sweepAppImagesConfig(region string) error {
-	ctx := sweep.Context(region)
+func sweepAppImagesConfig(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -207,8 +206,7 @@ func sweepAppImagesConfig(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSpaces(region string) error {
-	ctx := sweep.Context(region)
+func sweepSpaces(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -248,8 +246,7 @@ func sweepSpaces(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepApps(region string) error {
-	ctx := sweep.Context(region)
+func sweepApps(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -296,8 +293,7 @@ func sweepApps(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepCodeRepositories(region string) error {
-	ctx := sweep.Context(region)
+func sweepCodeRepositories(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -334,8 +330,7 @@ func sweepCodeRepositories(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDeviceFleets(region string) error {
-	ctx := sweep.Context(region)
+func sweepDeviceFleets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -374,8 +369,7 @@ func sweepDeviceFleets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDomains(region string) error {
-	ctx := sweep.Context(region)
+func sweepDomains(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -414,8 +408,7 @@ func sweepDomains(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepEndpointConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepEndpointConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -455,8 +448,7 @@ func sweepEndpointConfigurations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -488,8 +480,7 @@ func sweepEndpoints(region string) error {
 	return nil
 }
 
-func sweepFeatureGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepFeatureGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -526,8 +517,7 @@ func sweepFeatureGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepFlowDefinitions(region string) error {
-	ctx := sweep.Context(region)
+func sweepFlowDefinitions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -565,8 +555,7 @@ func sweepFlowDefinitions(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepHumanTaskUIs(region string) error {
-	ctx := sweep.Context(region)
+func sweepHumanTaskUIs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -604,8 +593,7 @@ func sweepHumanTaskUIs(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepImages(region string) error {
-	ctx := sweep.Context(region)
+func sweepImages(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -642,8 +630,7 @@ func sweepImages(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepModelPackageGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepModelPackageGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -680,8 +667,7 @@ func sweepModelPackageGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepModels(region string) error {
-	ctx := sweep.Context(region)
+func sweepModels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -718,8 +704,7 @@ func sweepModels(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepNotebookInstanceLifecycleConfiguration(region string) error {
-	ctx := sweep.Context(region)
+func sweepNotebookInstanceLifecycleConfiguration(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -761,8 +746,7 @@ func sweepNotebookInstanceLifecycleConfiguration(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepNotebookInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepNotebookInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -801,8 +785,7 @@ func sweepNotebookInstances(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepStudioLifecyclesConfig(region string) error {
-	ctx := sweep.Context(region)
+func sweepStudioLifecyclesConfig(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -840,8 +823,7 @@ func sweepStudioLifecyclesConfig(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepUserProfiles(region string) error {
-	ctx := sweep.Context(region)
+func sweepUserProfiles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -881,8 +863,7 @@ func sweepUserProfiles(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepWorkforces(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkforces(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -920,8 +901,7 @@ func sweepWorkforces(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepWorkteams(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkteams(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -959,8 +939,7 @@ func sweepWorkteams(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepProjects(region string) error {
-	ctx := sweep.Context(region)
+func sweepProjects(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -999,8 +978,7 @@ func sweepProjects(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPipelines(region string) error {
-	ctx := sweep.Context(region)
+func sweepPipelines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
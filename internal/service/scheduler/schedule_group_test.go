@@ -16,7 +16,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
-	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -29,10 +28,10 @@ import (
 func TestAccSchedulerScheduleGroup_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var scheduleGroup scheduler.GetScheduleGroupOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_scheduler_schedule_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
@@ -40,12 +39,12 @@ func TestAccSchedulerScheduleGroup_basic(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx),
+		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccScheduleGroupConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					testAccCheckScheduleGroupExists(ctx, t, resourceName, &scheduleGroup),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "scheduler", regexache.MustCompile(regexp.QuoteMeta(`schedule-group/`+rName))),
 					resource.TestCheckResourceAttrWith(resourceName, "creation_date", func(actual string) error {
 						expect := scheduleGroup.CreationDate.Format(time.RFC3339)
@@ -78,10 +77,10 @@ func TestAccSchedulerScheduleGroup_basic(t *testing.T) {
 func TestAccSchedulerScheduleGroup_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var scheduleGroup scheduler.GetScheduleGroupOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_scheduler_schedule_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
@@ -89,12 +88,12 @@ func TestAccSchedulerScheduleGroup_disappears(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx),
+		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccScheduleGroupConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					testAccCheckScheduleGroupExists(ctx, t, resourceName, &scheduleGroup),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfscheduler.ResourceScheduleGroup(), resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -108,7 +107,7 @@ func TestAccSchedulerScheduleGroup_nameGenerated(t *testing.T) {
 	var scheduleGroup scheduler.GetScheduleGroupOutput
 	resourceName := "aws_scheduler_schedule_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
@@ -116,12 +115,12 @@ func TestAccSchedulerScheduleGroup_nameGenerated(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx),
+		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccScheduleGroupConfig_nameGenerated,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					testAccCheckScheduleGroupExists(ctx, t, resourceName, &scheduleGroup),
 					acctest.CheckResourceAttrNameGenerated(resourceName, "name"),
 					resource.TestCheckResourceAttr(resourceName, "name_prefix", id.UniqueIdPrefix),
 				),
@@ -140,7 +139,7 @@ func TestAccSchedulerScheduleGroup_namePrefix(t *testing.T) {
 	var scheduleGroup scheduler.GetScheduleGroupOutput
 	resourceName := "aws_scheduler_schedule_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
@@ -148,12 +147,12 @@ func TestAccSchedulerScheduleGroup_namePrefix(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx),
+		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccScheduleGroupConfig_namePrefix("tf-acc-test-prefix-"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					testAccCheckScheduleGroupExists(ctx, t, resourceName, &scheduleGroup),
 					acctest.CheckResourceAttrNameFromPrefix(resourceName, "name", "tf-acc-test-prefix-"),
 					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tf-acc-test-prefix-"),
 				),
@@ -170,10 +169,10 @@ func TestAccSchedulerScheduleGroup_namePrefix(t *testing.T) {
 func TestAccSchedulerScheduleGroup_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var scheduleGroup scheduler.GetScheduleGroupOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_scheduler_schedule_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
@@ -181,12 +180,12 @@ func TestAccSchedulerScheduleGroup_tags(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx),
+		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccScheduleGroupConfig_tags1(rName, "key1", "value1"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					testAccCheckScheduleGroupExists(ctx, t, resourceName, &scheduleGroup),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
 				),
@@ -199,7 +198,7 @@ func TestAccSchedulerScheduleGroup_tags(t *testing.T) {
 			{
 				Config: testAccScheduleGroupConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					testAccCheckScheduleGroupExists(ctx, t, resourceName, &scheduleGroup),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
@@ -213,7 +212,7 @@ func TestAccSchedulerScheduleGroup_tags(t *testing.T) {
 			{
 				Config: testAccScheduleGroupConfig_tags1(rName, "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					testAccCheckScheduleGroupExists(ctx, t, resourceName, &scheduleGroup),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
 				),
@@ -227,9 +226,9 @@ func TestAccSchedulerScheduleGroup_tags(t *testing.T) {
 	})
 }
 
-func testAccCheckScheduleGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckScheduleGroupDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerClient(ctx)
+		conn := acctest.ProviderMeta(t).SchedulerClient(ctx)
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_scheduler_schedule_group" {
@@ -254,7 +253,7 @@ func testAccCheckScheduleGroupDestroy(ctx context.Context) resource.TestCheckFun
 	}
 }
 
-func testAccCheckScheduleGroupExists(ctx context.Context, name string, schedulegroup *scheduler.GetScheduleGroupOutput) resource.TestCheckFunc {
+func testAccCheckScheduleGroupExists(ctx context.Context, t *testing.T, name string, schedulegroup *scheduler.GetScheduleGroupOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -265,7 +264,7 @@ func testAccCheckScheduleGroupExists(ctx context.Context, name string, scheduleg
 			return create.Error(names.Scheduler, create.ErrActionCheckingExistence, tfscheduler.ResNameScheduleGroup, name, errors.New("not set"))
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerClient(ctx)
+		conn := acctest.ProviderMeta(t).SchedulerClient(ctx)
 
 		resp, err := conn.GetScheduleGroup(ctx, &scheduler.GetScheduleGroupInput{
 			Name: aws.String(rs.Primary.ID),
@@ -7,18 +7,18 @@
 package scheduler
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_scheduler_schedule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_scheduler_schedule_group", &sweep.Sweeper{
 		Name: "aws_scheduler_schedule_group",
 		F:    sweepScheduleGroups,
 		Dependencies: []string{
@@ -26,14 +26,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_scheduler_schedule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_scheduler_schedule", &sweep.Sweeper{
 		Name: "aws_scheduler_schedule",
 		F:    sweepSchedules,
 	})
 }
 
-func sweepScheduleGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepScheduleGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -63,6 +62,10 @@ func sweepScheduleGroups(region string) error {
 				continue
 			}
 
+			if !sweep.AllowResource(ctx, nil, aws.ToTime(it.CreationDate)) {
+				continue
+			}
+
 			r := ResourceScheduleGroup()
 			d := r.Data(nil)
 			d.SetId(name)
@@ -78,8 +81,7 @@ func sweepScheduleGroups(region string) error {
 	return nil
 }
 
-func sweepSchedules(region string) error {
-	ctx := sweep.Context(region)
+func sweepSchedules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -7,6 +7,7 @@
 package schemas
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,17 +15,16 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/schemas"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_schemas_discoverer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_schemas_discoverer", &sweep.Sweeper{
 		Name: "aws_schemas_discoverer",
 		F:    sweepDiscoverers,
 	})
 
-	resource.AddTestSweepers("aws_schemas_registry", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_schemas_registry", &sweep.Sweeper{
 		Name: "aws_schemas_registry",
 		F:    sweepRegistries,
 		Dependencies: []string{
@@ -32,14 +32,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_schemas_schema", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_schemas_schema", &sweep.Sweeper{
 		Name: "aws_schemas_registry",
 		F:    sweepSchemas,
 	})
 }
 
-func sweepDiscoverers(region string) error {
-	ctx := sweep.Context(region)
+func sweepDiscoverers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -81,8 +80,7 @@ func sweepDiscoverers(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRegistries(region string) error {
-	ctx := sweep.Context(region)
+func sweepRegistries(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -129,8 +127,7 @@ func sweepRegistries(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSchemas(region string) error { // nosemgrep:ci.schemas-in-func-name
-	ctx := sweep.Context(region)
+func sweepSchemas(ctx context.Context, region string) error { // nosemgrep:ci.schemas-in-func-name
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -16,9 +16,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -49,14 +51,28 @@ func ResourceSecretVersion() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secret_binary"},
+				ConflictsWith: []string{"secret_binary", "secret_string_wo"},
+			},
+			"secret_string_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"secret_binary", "secret_string"},
+				RequiredWith:  []string{"secret_string_wo_version"},
+			},
+			"secret_string_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"secret_string_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"secret_binary": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secret_string"},
+				ConflictsWith: []string{"secret_string", "secret_string_wo"},
 			},
 			"version_id": {
 				Type:     schema.TypeString,
@@ -85,6 +101,10 @@ func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, me
 		input.SecretString = aws.String(v.(string))
 	}
 
+	if v, ok := sdkv2.GetWriteOnlyString(d, "secret_string_wo"); ok {
+		input.SecretString = aws.String(v)
+	}
+
 	if v, ok := d.GetOk("secret_binary"); ok {
 		vs := []byte(v.(string))
 
@@ -176,7 +196,10 @@ func resourceSecretVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.Set("secret_id", secretID)
-	d.Set("secret_string", output.SecretString)
+	// A secret string written via secret_string_wo must not be read back into state.
+	if _, ok := d.GetOk("secret_string_wo_version"); !ok {
+		d.Set("secret_string", output.SecretString)
+	}
 	d.Set("secret_binary", verify.Base64Encode(output.SecretBinary))
 	d.Set("version_id", output.VersionId)
 	d.Set("arn", output.ARN)
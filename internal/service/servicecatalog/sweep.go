@@ -7,6 +7,7 @@
 package servicecatalog
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -16,36 +17,35 @@ import (
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_servicecatalog_budget_resource_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_budget_resource_association", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_budget_resource_association",
 		Dependencies: []string{},
 		F:            sweepBudgetResourceAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_constraint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_constraint", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_constraint",
 		Dependencies: []string{},
 		F:            sweepConstraints,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_principal_portfolio_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_principal_portfolio_association", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_principal_portfolio_association",
 		Dependencies: []string{},
 		F:            sweepPrincipalPortfolioAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_product_portfolio_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_product_portfolio_association", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_product_portfolio_association",
 		Dependencies: []string{},
 		F:            sweepProductPortfolioAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_product", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_product", &sweep.Sweeper{
 		Name: "aws_servicecatalog_product",
 		Dependencies: []string{
 			"aws_servicecatalog_provisioning_artifact",
@@ -53,39 +53,38 @@ func init() {
 		F: sweepProducts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_provisioned_product", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_provisioned_product", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_provisioned_product",
 		Dependencies: []string{},
 		F:            sweepProvisionedProducts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_provisioning_artifact", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_provisioning_artifact", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_provisioning_artifact",
 		Dependencies: []string{},
 		F:            sweepProvisioningArtifacts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_service_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_service_action", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_service_action",
 		Dependencies: []string{},
 		F:            sweepServiceActions,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_tag_option_resource_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_tag_option_resource_association", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_tag_option_resource_association",
 		Dependencies: []string{},
 		F:            sweepTagOptionResourceAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_tag_option", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_tag_option", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_tag_option",
 		Dependencies: []string{},
 		F:            sweepTagOptions,
 	})
 }
 
-func sweepBudgetResourceAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepBudgetResourceAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -196,8 +195,7 @@ func sweepBudgetResourceAssociations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepConstraints(region string) error {
-	ctx := sweep.Context(region)
+func sweepConstraints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -266,8 +264,7 @@ func sweepConstraints(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepPrincipalPortfolioAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepPrincipalPortfolioAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -339,8 +336,7 @@ func sweepPrincipalPortfolioAssociations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepProductPortfolioAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepProductPortfolioAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -432,8 +428,7 @@ func sweepProductPortfolioAssociations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepProducts(region string) error {
-	ctx := sweep.Context(region)
+func sweepProducts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -484,8 +479,7 @@ func sweepProducts(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepProvisionedProducts(region string) error {
-	ctx := sweep.Context(region)
+func sweepProvisionedProducts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -539,8 +533,7 @@ func sweepProvisionedProducts(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepProvisioningArtifacts(region string) error {
-	ctx := sweep.Context(region)
+func sweepProvisioningArtifacts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -621,8 +614,7 @@ func sweepProvisioningArtifacts(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepServiceActions(region string) error {
-	ctx := sweep.Context(region)
+func sweepServiceActions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -673,8 +665,7 @@ func sweepServiceActions(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepTagOptionResourceAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepTagOptionResourceAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -745,8 +736,7 @@ func sweepTagOptionResourceAssociations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepTagOptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepTagOptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -7,31 +7,30 @@
 package sesv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sesv2"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sesv2_configuration_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sesv2_configuration_set", &sweep.Sweeper{
 		Name: "aws_sesv2_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_sesv2_contact_list", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sesv2_contact_list", &sweep.Sweeper{
 		Name: "aws_sesv2_contact_list",
 		F:    sweepContactLists,
 	})
 }
 
-func sweepConfigurationSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigurationSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -77,8 +76,7 @@ func sweepConfigurationSets(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepContactLists(region string) error {
-	ctx := sweep.Context(region)
+func sweepContactLists(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
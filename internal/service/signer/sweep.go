@@ -7,25 +7,24 @@
 package signer
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/signer"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_signer_signing_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_signer_signing_profile", &sweep.Sweeper{
 		Name: "aws_signer_signing_profile",
 		F:    sweepSigningProfiles,
 	})
 }
 
-func sweepSigningProfiles(region string) error {
-	ctx := sweep.Context(region)
+func sweepSigningProfiles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
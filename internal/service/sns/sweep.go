@@ -7,22 +7,22 @@
 package sns
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sns"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sns_platform_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sns_platform_application", &sweep.Sweeper{
 		Name: "aws_sns_platform_application",
 		F:    sweepPlatformApplications,
 	})
 
-	resource.AddTestSweepers("aws_sns_topic", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sns_topic", &sweep.Sweeper{
 		Name: "aws_sns_topic",
 		F:    sweepTopics,
 		Dependencies: []string{
@@ -47,14 +47,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sns_topic_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sns_topic_subscription", &sweep.Sweeper{
 		Name: "aws_sns_topic_subscription",
 		F:    sweepTopicSubscriptions,
 	})
 }
 
-func sweepPlatformApplications(region string) error {
-	ctx := sweep.Context(region)
+func sweepPlatformApplications(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -97,8 +96,7 @@ func sweepPlatformApplications(region string) error {
 	return nil
 }
 
-func sweepTopics(region string) error {
-	ctx := sweep.Context(region)
+func sweepTopics(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -141,8 +139,7 @@ func sweepTopics(region string) error {
 	return nil
 }
 
-func sweepTopicSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepTopicSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
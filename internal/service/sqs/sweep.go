@@ -7,19 +7,19 @@
 package sqs
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sqs_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sqs_queue", &sweep.Sweeper{
 		Name: "aws_sqs_queue",
 		F:    sweepQueues,
 		Dependencies: []string{
@@ -34,8 +34,7 @@ func init() {
 	})
 }
 
-func sweepQueues(region string) error {
-	ctx := sweep.Context(region)
+func sweepQueues(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -19,7 +19,6 @@ import (
 	ssm_sdkv1 "github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -29,17 +28,17 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ssm_default_patch_baseline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_default_patch_baseline", &sweep.Sweeper{
 		Name: "aws_ssm_default_patch_baseline",
 		F:    sweepResourceDefaultPatchBaselines,
 	})
 
-	resource.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_maintenance_window", &sweep.Sweeper{
 		Name: "aws_ssm_maintenance_window",
 		F:    sweepMaintenanceWindows,
 	})
 
-	resource.AddTestSweepers("aws_ssm_patch_baseline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_patch_baseline", &sweep.Sweeper{
 		Name: "aws_ssm_patch_baseline",
 		F:    sweepResourcePatchBaselines,
 		Dependencies: []string{
@@ -47,14 +46,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_resource_data_sync", &sweep.Sweeper{
 		Name: "aws_ssm_resource_data_sync",
 		F:    sweepResourceDataSyncs,
 	})
 }
 
-func sweepResourceDefaultPatchBaselines(region string) error {
-	ctx := sweep.Context(region)
+func sweepResourceDefaultPatchBaselines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -118,8 +116,7 @@ func (s defaultPatchBaselineSweeper) Delete(ctx context.Context, timeout time.Du
 	return
 }
 
-func sweepMaintenanceWindows(region string) error {
-	ctx := sweep.Context(region)
+func sweepMaintenanceWindows(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -173,8 +170,7 @@ func sweepMaintenanceWindows(region string) error {
 	return nil
 }
 
-func sweepResourcePatchBaselines(region string) error {
-	ctx := sweep.Context(region)
+func sweepResourcePatchBaselines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -215,8 +211,7 @@ func sweepResourcePatchBaselines(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepResourceDataSyncs(region string) error {
-	ctx := sweep.Context(region)
+func sweepResourceDataSyncs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -7,6 +7,7 @@
 package ssoadmin
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -15,18 +16,17 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ssoadmin_account_assignment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssoadmin_account_assignment", &sweep.Sweeper{
 		Name: "aws_ssoadmin_account_assignment",
 		F:    sweepAccountAssignments,
 	})
 
-	resource.AddTestSweepers("aws_ssoadmin_permission_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssoadmin_permission_set", &sweep.Sweeper{
 		Name: "aws_ssoadmin_permission_set",
 		F:    sweepPermissionSets,
 		Dependencies: []string{
@@ -35,8 +35,7 @@ func init() {
 	})
 }
 
-func sweepAccountAssignments(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccountAssignments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -139,8 +138,7 @@ func sweepAccountAssignments(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPermissionSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepPermissionSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
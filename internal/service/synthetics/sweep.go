@@ -7,18 +7,18 @@
 package synthetics
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/synthetics"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_synthetics_canary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_synthetics_canary", &sweep.Sweeper{
 		Name: "aws_synthetics_canary",
 		F:    sweepCanaries,
 		Dependencies: []string{
@@ -29,8 +29,7 @@ func init() {
 	})
 }
 
-func sweepCanaries(region string) error {
-	ctx := sweep.Context(region)
+func sweepCanaries(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,31 +7,30 @@
 package timestreamwrite
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_timestreamwrite_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_timestreamwrite_database", &sweep.Sweeper{
 		Name:         "aws_timestreamwrite_database",
 		F:            sweepDatabases,
 		Dependencies: []string{"aws_timestreamwrite_table"},
 	})
 
-	resource.AddTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_timestreamwrite_table", &sweep.Sweeper{
 		Name: "aws_timestreamwrite_table",
 		F:    sweepTables,
 	})
 }
 
-func sweepDatabases(region string) error {
-	ctx := sweep.Context(region)
+func sweepDatabases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -71,8 +70,7 @@ func sweepDatabases(region string) error {
 	return nil
 }
 
-func sweepTables(region string) error {
-	ctx := sweep.Context(region)
+func sweepTables(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -352,12 +352,12 @@ func expandRetentionProperties(tfList []interface{}) *types.RetentionProperties
 
 	apiObject := &types.RetentionProperties{}
 
-	if v, ok := tfMap["magnetic_store_retention_period_in_days"].(int); ok {
-		apiObject.MagneticStoreRetentionPeriodInDays = int64(v)
+	if v, ok := tfMap["magnetic_store_retention_period_in_days"].(int); ok && v != 0 {
+		apiObject.MagneticStoreRetentionPeriodInDays = aws.Int64(int64(v))
 	}
 
-	if v, ok := tfMap["memory_store_retention_period_in_hours"].(int); ok {
-		apiObject.MemoryStoreRetentionPeriodInHours = int64(v)
+	if v, ok := tfMap["memory_store_retention_period_in_hours"].(int); ok && v != 0 {
+		apiObject.MemoryStoreRetentionPeriodInHours = aws.Int64(int64(v))
 	}
 
 	return apiObject
@@ -7,18 +7,18 @@
 package transcribe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_transcribe_language_model", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_language_model", &sweep.Sweeper{
 		Name: "aws_transcribe_language_model",
 		F:    sweepLanguageModels,
 		Dependencies: []string{
@@ -27,7 +27,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_medical_vocabulary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_medical_vocabulary", &sweep.Sweeper{
 		Name: "aws_transcribe_medical_vocabulary",
 		F:    sweepMedicalVocabularies,
 		Dependencies: []string{
@@ -35,7 +35,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_vocabulary", &sweep.Sweeper{
 		Name: "aws_transcribe_vocabulary",
 		F:    sweepVocabularies,
 		Dependencies: []string{
@@ -43,7 +43,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary_filter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_vocabulary_filter", &sweep.Sweeper{
 		Name: "aws_transcribe_vocabulary_filter",
 		F:    sweepVocabularyFilters,
 		Dependencies: []string{
@@ -52,8 +52,7 @@ func init() {
 	})
 }
 
-func sweepLanguageModels(region string) error {
-	ctx := sweep.Context(region)
+func sweepLanguageModels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
@@ -96,8 +95,7 @@ func sweepLanguageModels(region string) error {
 	return nil
 }
 
-func sweepMedicalVocabularies(region string) error {
-	ctx := sweep.Context(region)
+func sweepMedicalVocabularies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
@@ -141,8 +139,7 @@ func sweepMedicalVocabularies(region string) error {
 	return nil
 }
 
-func sweepVocabularies(region string) error {
-	ctx := sweep.Context(region)
+func sweepVocabularies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
@@ -186,8 +183,7 @@ func sweepVocabularies(region string) error {
 	return nil
 }
 
-func sweepVocabularyFilters(region string) error {
-	ctx := sweep.Context(region)
+func sweepVocabularyFilters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
@@ -7,22 +7,22 @@
 package transfer
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/transfer"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_transfer_server", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transfer_server", &sweep.Sweeper{
 		Name: "aws_transfer_server",
 		F:    sweepServers,
 	})
 
-	resource.AddTestSweepers("aws_transfer_workflow", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transfer_workflow", &sweep.Sweeper{
 		Name: "aws_transfer_workflow",
 		F:    sweepWorkflows,
 		Dependencies: []string{
@@ -31,8 +31,7 @@ func init() {
 	})
 }
 
-func sweepServers(region string) error {
-	ctx := sweep.Context(region)
+func sweepServers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -77,8 +76,7 @@ func sweepServers(region string) error {
 	return nil
 }
 
-func sweepWorkflows(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkflows(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -7,24 +7,24 @@
 package vpclattice
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpclattice_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpclattice_service", &sweep.Sweeper{
 		Name: "aws_vpclattice_service",
 		F:    sweepServices,
 	})
 
-	resource.AddTestSweepers("aws_vpclattice_service_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpclattice_service_network", &sweep.Sweeper{
 		Name: "aws_vpclattice_service_network",
 		F:    sweepServiceNetworks,
 		Dependencies: []string{
@@ -33,8 +33,7 @@ func init() {
 	})
 }
 
-func sweepServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -74,8 +73,7 @@ func sweepServices(region string) error {
 	return nil
 }
 
-func sweepServiceNetworks(region string) error {
-	ctx := sweep.Context(region)
+func sweepServiceNetworks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
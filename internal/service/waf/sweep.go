@@ -7,6 +7,7 @@
 package waf
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sync"
@@ -14,13 +15,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_byte_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_byte_match_set", &sweep.Sweeper{
 		Name: "aws_waf_byte_match_set",
 		F:    sweepByteMatchSet,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_geo_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_geo_match_set", &sweep.Sweeper{
 		Name: "aws_waf_geo_match_set",
 		F:    sweepGeoMatchSet,
 		Dependencies: []string{
@@ -40,7 +40,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_ipset", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_ipset", &sweep.Sweeper{
 		Name: "aws_waf_ipset",
 		F:    sweepIPSet,
 		Dependencies: []string{
@@ -50,7 +50,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rate_based_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rate_based_rule", &sweep.Sweeper{
 		Name: "aws_waf_rate_based_rule",
 		F:    sweepRateBasedRules,
 		Dependencies: []string{
@@ -59,7 +59,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_regex_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_regex_match_set", &sweep.Sweeper{
 		Name: "aws_waf_regex_match_set",
 		F:    sweepRegexMatchSet,
 		Dependencies: []string{
@@ -69,7 +69,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_regex_pattern_set", &sweep.Sweeper{
 		Name: "aws_waf_regex_pattern_set",
 		F:    sweepRegexPatternSet,
 		Dependencies: []string{
@@ -79,7 +79,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rule_group", &sweep.Sweeper{
 		Name: "aws_waf_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -87,7 +87,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rule", &sweep.Sweeper{
 		Name: "aws_waf_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -96,7 +96,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_size_constraint_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_size_constraint_set", &sweep.Sweeper{
 		Name: "aws_waf_size_constraint_set",
 		F:    sweepSizeConstraintSet,
 		Dependencies: []string{
@@ -106,7 +106,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_sql_injection_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_sql_injection_match_set", &sweep.Sweeper{
 		Name: "aws_waf_sql_injection_match_set",
 		F:    sweepSQLInjectionMatchSet,
 		Dependencies: []string{
@@ -116,12 +116,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_web_acl", &sweep.Sweeper{
 		Name: "aws_waf_web_acl",
 		F:    sweepWebACLs,
 	})
 
-	resource.AddTestSweepers("aws_waf_xss_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_xss_match_set", &sweep.Sweeper{
 		Name: "aws_waf_xss_match_set",
 		F:    sweepXSSMatchSet,
 		Dependencies: []string{
@@ -132,8 +132,7 @@ func init() {
 	})
 }
 
-func sweepByteMatchSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepByteMatchSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -207,8 +206,7 @@ func sweepByteMatchSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepGeoMatchSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepGeoMatchSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -282,8 +280,7 @@ func sweepGeoMatchSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepIPSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepIPSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -357,8 +354,7 @@ func sweepIPSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRateBasedRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepRateBasedRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -432,8 +428,7 @@ func sweepRateBasedRules(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRegexMatchSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepRegexMatchSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -507,8 +502,7 @@ func sweepRegexMatchSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRegexPatternSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepRegexPatternSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -582,8 +576,7 @@ func sweepRegexPatternSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRuleGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepRuleGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -657,8 +650,7 @@ func sweepRuleGroups(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -736,8 +728,7 @@ func sweepRules(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSizeConstraintSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepSizeConstraintSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -811,8 +802,7 @@ func sweepSizeConstraintSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSQLInjectionMatchSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepSQLInjectionMatchSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -886,8 +876,7 @@ func sweepSQLInjectionMatchSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepWebACLs(region string) error {
-	ctx := sweep.Context(region)
+func sweepWebACLs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -965,8 +954,7 @@ func sweepWebACLs(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepXSSMatchSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepXSSMatchSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -7,18 +7,18 @@
 package wafv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_ip_set", &sweep.Sweeper{
 		Name: "aws_wafv2_ip_set",
 		F:    sweepIPSets,
 		Dependencies: []string{
@@ -27,7 +27,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_regex_pattern_set", &sweep.Sweeper{
 		Name: "aws_wafv2_regex_pattern_set",
 		F:    sweepRegexPatternSets,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_rule_group", &sweep.Sweeper{
 		Name: "aws_wafv2_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -44,14 +44,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_web_acl", &sweep.Sweeper{
 		Name: "aws_wafv2_web_acl",
 		F:    sweepWebACLs,
 	})
 }
 
-func sweepIPSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepIPSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -99,8 +98,7 @@ func sweepIPSets(region string) error {
 	return nil
 }
 
-func sweepRegexPatternSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepRegexPatternSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -148,8 +146,7 @@ func sweepRegexPatternSets(region string) error {
 	return nil
 }
 
-func sweepRuleGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepRuleGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -197,8 +194,7 @@ func sweepRuleGroups(region string) error {
 	return nil
 }
 
-func sweepWebACLs(region string) error {
-	ctx := sweep.Context(region)
+func sweepWebACLs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
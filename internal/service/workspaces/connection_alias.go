@@ -32,6 +32,7 @@ import (
 var ResourceConnectionAlias = newResourceConnectionAlias
 
 // @FrameworkResource(name="Connection Alias")
+// @Sweeper(lister=listSweepableConnectionAliases)
 // @Tags(identifierAttribute="id")
 func newResourceConnectionAlias(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceConnectionAlias{}
@@ -13,13 +13,15 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/workspaces"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_workspaces_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_directory", &sweep.Sweeper{
 		Name: "aws_workspaces_directory",
 		F:    sweepDirectories,
 		Dependencies: []string{
@@ -28,19 +30,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_workspaces_ip_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_ip_group", &sweep.Sweeper{
 		Name: "aws_workspaces_ip_group",
 		F:    sweepIPGroups,
 	})
 
-	resource.AddTestSweepers("aws_workspaces_workspace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_workspace", &sweep.Sweeper{
 		Name: "aws_workspaces_workspace",
 		F:    sweepWorkspace,
 	})
 }
 
-func sweepDirectories(region string) error {
-	ctx := sweep.Context(region)
+func sweepDirectories(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -80,8 +81,7 @@ func sweepDirectories(region string) error {
 	return nil
 }
 
-func sweepIPGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepIPGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -141,8 +141,7 @@ func describeIPGroupsPages(ctx context.Context, conn *workspaces.Client, input *
 	return nil
 }
 
-func sweepWorkspace(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkspace(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -181,3 +180,35 @@ func sweepWorkspace(region string) error {
 
 	return nil
 }
+
+func listSweepableConnectionAliases(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.WorkSpacesClient(ctx)
+	input := &workspaces.DescribeConnectionAliasesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for {
+		output, err := conn.DescribeConnectionAliases(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output.ConnectionAliases {
+			if v.State == types.ConnectionAliasStateDeleting {
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceConnectionAlias, client,
+				framework.NewAttribute("id", aws.ToString(v.AliasId)),
+			))
+		}
+
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweepResources, nil
+}
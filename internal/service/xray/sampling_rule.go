@@ -122,14 +122,20 @@ func resourceSamplingRuleCreate(ctx context.Context, d *schema.ResourceData, met
 		FixedRate:     d.Get("fixed_rate").(float64),
 		Host:          aws.String(d.Get("host").(string)),
 		HTTPMethod:    aws.String(d.Get("http_method").(string)),
-		Priority:      int32(d.Get("priority").(int)),
 		ReservoirSize: int32(d.Get("reservoir_size").(int)),
 		ResourceARN:   aws.String(d.Get("resource_arn").(string)),
 		RuleName:      aws.String(name),
 		ServiceName:   aws.String(d.Get("service_name").(string)),
 		ServiceType:   aws.String(d.Get("service_type").(string)),
 		URLPath:       aws.String(d.Get("url_path").(string)),
-		Version:       int32(d.Get("version").(int)),
+	}
+
+	if v := d.Get("priority").(int); v != 0 {
+		samplingRule.Priority = aws.Int32(int32(v))
+	}
+
+	if v := d.Get("version").(int); v != 0 {
+		samplingRule.Version = aws.Int32(int32(v))
 	}
 
 	if v, ok := d.GetOk("attributes"); ok && len(v.(map[string]interface{})) > 0 {
@@ -1,10 +0,0 @@
-// Copyright (c) HashiCorp, Inc.
-// SPDX-License-Identifier: MPL-2.0
-
-package sweep
-
-import "context"
-
-func Context(region string) context.Context {
-	return context.Background()
-}
@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+// Exports for use in tests only.
+var (
+	ExcludedSweepers = excludedSweepers
+	Sweepers         = sweepers
+)
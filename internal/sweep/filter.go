@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Filter restricts sweeping to resources that match all of its conditions.
+// It narrows, rather than replaces, any resource name checks (e.g. against ResourcePrefix) made by sweepers.
+type Filter struct {
+	// Tags are the tags that a resource must have. An empty value matches any value.
+	Tags map[string]string
+
+	// MinAge is the minimum time since a resource was created.
+	MinAge time.Duration
+}
+
+// ParseFilter returns a Filter for the specified comma-separated list of `key=value` or `key` tags and minimum age.
+// A nil Filter is returned if neither tags nor minimum age are specified.
+func ParseFilter(tags string, minAge time.Duration) (*Filter, error) {
+	if minAge < 0 {
+		return nil, fmt.Errorf("invalid minimum age (%s): must not be negative", minAge)
+	}
+
+	f := &Filter{
+		MinAge: minAge,
+	}
+
+	for _, v := range strings.Split(tags, ",") {
+		if v == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(v, "=")
+
+		if key == "" {
+			return nil, fmt.Errorf("invalid tag filter (%s): missing key", v)
+		}
+
+		if f.Tags == nil {
+			f.Tags = make(map[string]string)
+		}
+		f.Tags[key] = value
+	}
+
+	if f.isEmpty() {
+		return nil, nil
+	}
+
+	return f, nil
+}
+
+func (f *Filter) isEmpty() bool {
+	return f == nil || (len(f.Tags) == 0 && f.MinAge == 0)
+}
+
+// Allow returns whether a resource with the specified tags and creation time matches the filter at the specified time.
+// A resource whose tags or creation time is unknown (nil or zero) does not match a filter that requires them.
+func (f *Filter) Allow(tags map[string]string, created, now time.Time) bool {
+	if !f.allowTags(tags) {
+		return false
+	}
+
+	if !f.isEmpty() && f.MinAge > 0 && (created.IsZero() || now.Sub(created) < f.MinAge) {
+		return false
+	}
+
+	return true
+}
+
+// allowTags returns whether a resource with the specified tags matches the filter's tag conditions.
+func (f *Filter) allowTags(tags map[string]string) bool {
+	if f.isEmpty() {
+		return true
+	}
+
+	for key, want := range f.Tags {
+		got, ok := tags[key]
+
+		if !ok || (want != "" && got != want) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AllowResource returns whether a resource with the specified tags and creation time may be swept.
+// Sweepers must consult AllowResource for each resource they find when the sweeper run has a Filter,
+// otherwise SweepOrchestrator deletes none of the resources. Pass nil tags or a zero creation time if the value is not known.
+// Resources that are not allowed are counted as found and skipped.
+func AllowResource(ctx context.Context, tags map[string]string, created time.Time) bool {
+	return allowResource(ctx, optionsFromContext(ctx).filter.Allow(tags, created, time.Now()))
+}
+
+// AllowResourceWithoutCreationTime is AllowResource for resource types whose creation time is not available from the AWS API.
+// The resource's age cannot be determined, so no resource matches a Filter with a minimum age.
+func AllowResourceWithoutCreationTime(ctx context.Context, tags map[string]string) bool {
+	filter := optionsFromContext(ctx).filter
+
+	return allowResource(ctx, filter.allowTags(tags) && (filter.isEmpty() || filter.MinAge == 0))
+}
+
+func allowResource(ctx context.Context, allow bool) bool {
+	stats := statsFromContext(ctx)
+	stats.filtered.Store(true)
+
+	if allow {
+		return true
+	}
+
+	stats.found.Add(1)
+	stats.skipped.Add(1)
+
+	return false
+}
+
+// options configures a sweeper run.
+type options struct {
+	// dryRun lists the resources that would be deleted without deleting them.
+	dryRun bool
+
+	// filter restricts sweeping to matching resources.
+	filter *Filter
+}
+
+// isRestricted returns whether the sweeper run may only delete resources via SweepOrchestrator.
+func (o options) isRestricted() bool {
+	return o.dryRun || !o.filter.isEmpty()
+}
+
+type optionsKey struct{}
+
+// withOptions returns a new Context that carries the specified sweeper run options.
+func withOptions(ctx context.Context, opts options) context.Context {
+	return context.WithValue(ctx, optionsKey{}, opts)
+}
+
+// optionsFromContext returns the sweeper run options carried by the Context.
+func optionsFromContext(ctx context.Context) options {
+	if v, ok := ctx.Value(optionsKey{}).(options); ok {
+		return v
+	}
+
+	return options{}
+}
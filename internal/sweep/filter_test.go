@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tags        string
+		minAge      time.Duration
+		want        *Filter
+		expectError bool
+	}{
+		"empty": {},
+		"tags": {
+			tags: "CreatedBy=ci,Ephemeral",
+			want: &Filter{
+				Tags: map[string]string{
+					"CreatedBy": "ci",
+					"Ephemeral": "",
+				},
+			},
+		},
+		"min age": {
+			minAge: 2 * time.Hour,
+			want: &Filter{
+				MinAge: 2 * time.Hour,
+			},
+		},
+		"missing key": {
+			tags:        "=ci",
+			expectError: true,
+		},
+		"negative min age": {
+			minAge:      -1 * time.Hour,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseFilter(testCase.tags, testCase.minAge)
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got none")
+			}
+			if err != nil && !testCase.expectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterAllow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.September, 1, 12, 0, 0, 0, time.UTC)
+	filter := &Filter{
+		Tags: map[string]string{
+			"CreatedBy": "ci",
+			"Ephemeral": "",
+		},
+		MinAge: time.Hour,
+	}
+
+	testCases := map[string]struct {
+		filter  *Filter
+		tags    map[string]string
+		created time.Time
+		want    bool
+	}{
+		"no filter": {
+			want: true,
+		},
+		"match": {
+			filter:  filter,
+			tags:    map[string]string{"CreatedBy": "ci", "Ephemeral": "true", "Name": "example"},
+			created: now.Add(-2 * time.Hour),
+			want:    true,
+		},
+		"tag value mismatch": {
+			filter:  filter,
+			tags:    map[string]string{"CreatedBy": "someone", "Ephemeral": "true"},
+			created: now.Add(-2 * time.Hour),
+		},
+		"tag missing": {
+			filter:  filter,
+			tags:    map[string]string{"CreatedBy": "ci"},
+			created: now.Add(-2 * time.Hour),
+		},
+		"tags unknown": {
+			filter:  filter,
+			created: now.Add(-2 * time.Hour),
+		},
+		"too new": {
+			filter:  filter,
+			tags:    map[string]string{"CreatedBy": "ci", "Ephemeral": "true"},
+			created: now.Add(-30 * time.Minute),
+		},
+		"creation time unknown": {
+			filter: filter,
+			tags:   map[string]string{"CreatedBy": "ci", "Ephemeral": "true"},
+		},
+		"creation time unknown no min age": {
+			filter: &Filter{Tags: map[string]string{"CreatedBy": "ci"}},
+			tags:   map[string]string{"CreatedBy": "ci"},
+			want:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.filter.Allow(testCase.tags, testCase.created, now), testCase.want; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestAllowResourceWithoutCreationTime(t *testing.T) {
+	t.Parallel()
+
+	ctx := withStats(withOptions(context.Background(), options{filter: &Filter{Tags: map[string]string{"CreatedBy": "ci"}}}), &stats{})
+
+	if !AllowResourceWithoutCreationTime(ctx, map[string]string{"CreatedBy": "ci"}) {
+		t.Error("AllowResourceWithoutCreationTime: resource not allowed, want allowed")
+	}
+	if AllowResourceWithoutCreationTime(ctx, map[string]string{"CreatedBy": "someone"}) {
+		t.Error("AllowResourceWithoutCreationTime: resource allowed, want not allowed")
+	}
+}
+
+func TestAllowResourceWithoutCreationTimeMinAge(t *testing.T) {
+	t.Parallel()
+
+	stats := &stats{}
+	ctx := withStats(withOptions(context.Background(), options{filter: &Filter{Tags: map[string]string{"CreatedBy": "ci"}, MinAge: time.Hour}}), stats)
+
+	if AllowResource(ctx, map[string]string{"CreatedBy": "ci"}, time.Time{}) {
+		t.Error("AllowResource: resource allowed, want not allowed")
+	}
+	if AllowResourceWithoutCreationTime(ctx, map[string]string{"CreatedBy": "ci"}) {
+		t.Error("AllowResourceWithoutCreationTime: resource allowed, want not allowed")
+	}
+
+	got := []int64{stats.found.Load(), stats.skipped.Load()}
+	want := []int64{2, 2}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSweepOrchestratorDryRun(t *testing.T) {
+	t.Parallel()
+
+	stats := &stats{resourceType: "aws_example_thing"}
+	ctx := withStats(withOptions(context.Background(), options{dryRun: true}), stats)
+	sweepables := []*mockSweepable{{}, {}}
+
+	if err := SweepOrchestrator(ctx, []Sweepable{sweepables[0], sweepables[1]}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, v := range sweepables {
+		if v.deleted.Load() {
+			t.Error("resource deleted during dry run")
+		}
+	}
+
+	got := []int64{stats.found.Load(), stats.deleted.Load(), stats.skipped.Load(), stats.failed.Load()}
+	want := []int64{2, 0, 2, 0}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSweepOrchestratorFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		consultFilter bool
+		wantDeleted   bool
+		want          []int64
+	}{
+		"filter not consulted": {
+			want: []int64{1, 0, 1, 0},
+		},
+		"filter consulted": {
+			consultFilter: true,
+			wantDeleted:   true,
+			want:          []int64{2, 1, 1, 0},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stats := &stats{resourceType: "aws_example_thing"}
+			ctx := withStats(withOptions(context.Background(), options{filter: &Filter{Tags: map[string]string{"CreatedBy": "ci"}}}), stats)
+			sweepable := &mockSweepable{}
+
+			if testCase.consultFilter {
+				if AllowResource(ctx, map[string]string{"CreatedBy": "someone"}, time.Time{}) {
+					t.Error("resource allowed, want not allowed")
+				}
+				if !AllowResource(ctx, map[string]string{"CreatedBy": "ci"}, time.Time{}) {
+					t.Error("resource not allowed, want allowed")
+				}
+			}
+
+			if err := SweepOrchestrator(ctx, []Sweepable{sweepable}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := sweepable.deleted.Load(), testCase.wantDeleted; got != want {
+				t.Errorf("deleted: got %t, want %t", got, want)
+			}
+
+			got := []int64{stats.found.Load(), stats.deleted.Load(), stats.skipped.Load(), stats.failed.Load()}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckOperation(t *testing.T) {
+	t.Parallel()
+
+	restricted := withOptions(context.Background(), options{dryRun: true})
+
+	testCases := map[string]struct {
+		ctx         context.Context
+		operation   string
+		expectError bool
+	}{
+		"unrestricted": {
+			ctx:       context.Background(),
+			operation: "DeleteVpc",
+		},
+		"read-only": {
+			ctx:       restricted,
+			operation: "DescribeVpcs",
+		},
+		"modify": {
+			ctx:         restricted,
+			operation:   "DeleteVpc",
+			expectError: true,
+		},
+		"modify via SweepOrchestrator": {
+			ctx:       withDeleteAllowed(restricted),
+			operation: "DeleteVpc",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkOperation(testCase.ctx, testCase.operation)
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got none")
+			}
+			if err != nil {
+				if !testCase.expectError {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if !errors.Is(err, errOperationNotAllowed) {
+					t.Errorf("unexpected error: %s", err)
+				}
+			}
+		})
+	}
+}
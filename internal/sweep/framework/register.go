@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+// ListerFunc returns the resources of a single type in the client's AWS Region that are to be swept.
+type ListerFunc func(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error)
+
+// Register registers a sweeper for the Plugin Framework resource returned by the specified factory.
+// The sweeper deletes the resources returned by the lister.
+// Registration is normally generated from a resource's `@Sweeper` annotation.
+func Register(factory func(context.Context) (fwresource.ResourceWithConfigure, error), lister ListerFunc, dependencies ...string) {
+	name := typeName(factory)
+
+	sweep.AddTestSweepers(name, &sweep.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		F: func(ctx context.Context, region string) error {
+			client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+			if err != nil {
+				return fmt.Errorf("getting client: %w", err)
+			}
+
+			sweepResources, err := lister(ctx, client)
+
+			if awsv1.SkipSweepError(err) || awsv2.SkipSweepError(err) {
+				log.Printf("[WARN] Skipping %s sweep for %s: %s", name, region, err)
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("listing %s for %s: %w", name, region, err)
+			}
+
+			if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+				return fmt.Errorf("sweeping %s for %s: %w", name, region, err)
+			}
+
+			return nil
+		},
+	})
+}
+
+// Exclude records that the Plugin Framework resource returned by the specified factory deliberately has no sweeper.
+// Exclusion is normally generated from a resource's `@NoSweeper` annotation.
+func Exclude(factory func(context.Context) (fwresource.ResourceWithConfigure, error), reason string) {
+	sweep.Exclude(typeName(factory), reason)
+}
+
+func typeName(factory func(context.Context) (fwresource.ResourceWithConfigure, error)) string {
+	ctx := context.Background()
+	resource, err := factory(ctx)
+
+	if err != nil {
+		log.Fatalf("[ERR] creating Plugin Framework resource: %s", err)
+	}
+
+	return resourceMetadata(ctx, resource).TypeName
+}
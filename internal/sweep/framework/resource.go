@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -45,6 +46,17 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
+// String returns the resource's identifying attributes, e.g. `id=example`.
+func (sr *sweepResource) String() string {
+	parts := make([]string, 0, len(sr.attributes))
+
+	for _, attr := range sr.attributes {
+		parts = append(parts, fmt.Sprintf("%s=%v", attr.path, attr.value))
+	}
+
+	return strings.Join(parts, ",")
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	resource, err := sr.factory(ctx)
 
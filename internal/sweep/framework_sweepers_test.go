@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package sweep_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+// TestFrameworkResourceSweepers checks that every Plugin Framework resource either has a sweeper
+// or is explicitly excluded from sweeping via a `@NoSweeper` annotation.
+//
+// The test requires the sweep build tag: sweepers are registered by each service package's sweep.go,
+// which is only built with that tag, so without it every resource would be reported as having no sweeper.
+// It is run by `make sweeper-check` and in CI.
+func TestFrameworkResourceSweepers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p, err := provider.New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, sp := range p.Meta().(*conns.AWSClient).ServicePackages {
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+
+			if err != nil {
+				t.Errorf("creating resource (%s): %s", sp.ServicePackageName(), err)
+				continue
+			}
+
+			var response resource.MetadataResponse
+			r.Metadata(ctx, resource.MetadataRequest{}, &response)
+			typeName := response.TypeName
+
+			_, hasSweeper := sweep.Sweepers[typeName]
+			_, excluded := sweep.ExcludedSweepers[typeName]
+
+			switch {
+			case hasSweeper && excluded:
+				t.Errorf("resource %s has a sweeper and is excluded from sweeping", typeName)
+			case !hasSweeper && !excluded:
+				t.Errorf("resource %s has no sweeper: add a @Sweeper or @NoSweeper annotation", typeName)
+			}
+		}
+	}
+}
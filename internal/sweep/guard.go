@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// errOperationNotAllowed is returned for AWS API operations that could modify resources
+// outside SweepOrchestrator during a dry run or a filtered sweeper run.
+var errOperationNotAllowed = errors.New("operation not allowed outside SweepOrchestrator during a dry run or filtered sweep")
+
+// readOnlyOperationPrefixes are the name prefixes of AWS API operations that do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type deleteAllowedKey struct{}
+
+// withDeleteAllowed returns a new Context in which AWS API operations that modify resources are allowed.
+func withDeleteAllowed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, deleteAllowedKey{}, true)
+}
+
+// checkOperation returns an error if the named AWS API operation is not allowed in the specified Context.
+func checkOperation(ctx context.Context, name string) error {
+	if !optionsFromContext(ctx).isRestricted() || isReadOnlyOperation(name) {
+		return nil
+	}
+
+	if v, ok := ctx.Value(deleteAllowedKey{}).(bool); ok && v {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %w", name, errOperationNotAllowed)
+}
+
+// guardClient ensures that during a dry run or filtered sweeper run resources are only modified
+// via SweepOrchestrator, so that sweepers that delete resources directly cannot bypass the restrictions.
+func guardClient(client *conns.AWSClient) {
+	if client.Session != nil {
+		client.Session.Handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+			Name: "SweeperOperationGuard",
+			Fn: func(r *request_sdkv1.Request) {
+				if err := checkOperation(r.Context(), r.Operation.Name); err != nil {
+					r.Error = err
+				}
+			},
+		})
+	}
+
+	client.UpdateAWSConfig(func(cfg *aws_sdkv2.Config) {
+		cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+			return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("SweeperOperationGuard", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				if err := checkOperation(ctx, awsmiddleware.GetOperationName(ctx)); err != nil {
+					return middleware.InitializeOutput{}, middleware.Metadata{}, err
+				}
+
+				return next.HandleInitialize(ctx, in)
+			}), middleware.After)
+		})
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"log"
+)
+
+// SweeperFunc sweeps all resources of a single type in the specified AWS Region.
+type SweeperFunc func(ctx context.Context, region string) error
+
+// Sweeper sweeps all resources of a single type.
+type Sweeper struct {
+	// Name is the resource type name, e.g. "aws_vpc".
+	Name string
+
+	// Dependencies are the names of the sweepers that must run before this sweeper,
+	// i.e. sweepers for resource types that reference this resource type.
+	Dependencies []string
+
+	// F is the sweeper function.
+	F SweeperFunc
+}
+
+// sweepers are the registered sweepers, keyed by name.
+var sweepers = make(map[string]*Sweeper)
+
+// AddTestSweepers registers a sweeper.
+// Sweepers are run via TestMain when the -sweep flag is used with `go test`.
+func AddTestSweepers(name string, s *Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] adding sweeper (%s): sweeper already exists", name)
+	}
+
+	sweepers[name] = s
+}
+
+// excludedSweepers are the resource types explicitly excluded from sweeping, keyed by name, with the reason.
+var excludedSweepers = make(map[string]string)
+
+// Exclude records that the named resource type deliberately has no sweeper, e.g. because its resources
+// are deleted along with their parent resources.
+func Exclude(name, reason string) {
+	if _, ok := excludedSweepers[name]; ok {
+		log.Fatalf("[ERR] excluding sweeper (%s): sweeper already excluded", name)
+	}
+
+	excludedSweepers[name] = reason
+}
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+const defaultSweepParallelism = 10
+
+var (
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "List the resources that Sweepers would delete without deleting them")
+	flagSweepMinAge      = flag.Duration("sweep-min-age", 0, "Only sweep resources created at least this long ago")
+	flagSweepParallelism = flag.Int("sweep-parallelism", defaultSweepParallelism, "Maximum number of Sweepers to run concurrently in each Region")
+	flagSweepTags        = flag.String("sweep-tags", "", "Comma separated list of key=value or key tags that swept resources must have")
+)
+
+// TestMain runs the registered sweepers if the -sweep flag is used with `go test`, otherwise it runs the tests.
+// The -sweep, -sweep-run and -sweep-allow-failures flags are those defined by the Terraform Plugin Testing library.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	v := flagValue("sweep")
+
+	if v == "" {
+		resource.TestMain(m)
+
+		return
+	}
+
+	filter, err := ParseFilter(*flagSweepTags, *flagSweepMinAge)
+
+	if err != nil {
+		log.Printf("[ERR] %s", err)
+		os.Exit(1)
+	}
+
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+	regions := strings.Split(v, ",")
+	ctx := withOptions(context.Background(), options{
+		dryRun: *flagSweepDryRun,
+		filter: filter,
+	})
+
+	if _, err := runSweepers(ctx, regions, filterSweepers(flagValue("sweep-run"), sweepers), allowFailures, *flagSweepParallelism); err != nil {
+		log.Printf("[ERR] %s", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated filter values, along with their dependencies.
+// All sweepers are returned if the filter is empty.
+func filterSweepers(filter string, source map[string]*Sweeper) map[string]*Sweeper {
+	if filter == "" {
+		return source
+	}
+
+	result := make(map[string]*Sweeper)
+
+	var add func(name string)
+	add = func(name string) {
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		s, ok := source[name]
+
+		if !ok {
+			return
+		}
+
+		result[name] = s
+
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for name := range source {
+		for _, v := range strings.Split(strings.ToLower(filter), ",") {
+			if v != "" && strings.Contains(strings.ToLower(name), v) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// sweeperGraph returns the dependency graph of the specified sweepers.
+// An error is returned if the graph contains a dependency cycle.
+func sweeperGraph(sweepers map[string]*Sweeper) (*depgraph.Graph, []string, error) {
+	g := depgraph.New()
+
+	names := make([]string, 0, len(sweepers))
+	for name := range sweepers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if !g.HasNode(dependency) {
+				log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+				continue
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("sweeper dependencies: %w", err)
+	}
+
+	return g, order, nil
+}
+
+// sweeperResult is the outcome of running a single sweeper in a single Region.
+type sweeperResult struct {
+	name     string
+	stats    *stats
+	err      error
+	ran      bool
+	skipped  bool
+	duration time.Duration
+}
+
+// runSweepers runs the specified sweepers in each Region in turn.
+// The per-Region results are returned.
+func runSweepers(ctx context.Context, regions []string, sweepers map[string]*Sweeper, allowFailures bool, parallelism int) (map[string][]*sweeperResult, error) {
+	g, order, err := sweeperGraph(sweepers)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var errs *multierror.Error
+	results := make(map[string][]*sweeperResult)
+
+	for _, region := range regions {
+		regionResults, err := runRegionSweepers(ctx, region, g, order, sweepers, allowFailures, parallelism)
+
+		results[region] = regionResults
+		logSummary(region, regionResults)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("sweeping Region (%s): %w", region, err))
+
+			if !allowFailures {
+				break
+			}
+		}
+	}
+
+	return results, errs.ErrorOrNil()
+}
+
+// runRegionSweepers runs sweepers in a single Region.
+// A sweeper runs once all the sweepers it depends on have completed. At most parallelism sweepers run concurrently.
+// Unless failures are allowed, no more sweepers are started after a sweeper fails and any in-progress sweeps are abandoned.
+func runRegionSweepers(ctx context.Context, region string, g *depgraph.Graph, order []string, sweepers map[string]*Sweeper, allowFailures bool, parallelism int) ([]*sweeperResult, error) {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(map[string]*sweeperResult, len(order))
+	pending := make(map[string]int, len(order))
+	ready := make([]string, 0)
+
+	for _, name := range order {
+		results[name] = &sweeperResult{name: name, stats: &stats{resourceType: name}}
+
+		dependencies, err := g.DirectDependenciesOf(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		pending[name] = len(dependencies)
+
+		if len(dependencies) == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	var errs *multierror.Error
+	done := make(chan *sweeperResult)
+	running := 0
+	failed := false
+
+	for {
+		for !failed && len(ready) > 0 && running < parallelism {
+			name := ready[0]
+			ready = ready[1:]
+			running++
+
+			go func(s *Sweeper, result *sweeperResult) {
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", s.Name, region)
+
+				start := time.Now()
+				result.err = s.F(withStats(ctx, result.stats), region)
+				result.duration = time.Since(start)
+				result.ran = true
+
+				log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", s.Name, region, result.duration)
+
+				done <- result
+			}(sweepers[name], results[name])
+		}
+
+		if running == 0 {
+			break
+		}
+
+		result := <-done
+		running--
+
+		// Sweepers that modify resources outside SweepOrchestrator are skipped during a dry run or filtered sweep.
+		if errors.Is(result.err, errOperationNotAllowed) {
+			log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): %s", result.name, region, result.err)
+			result.skipped = true
+			result.err = nil
+		}
+
+		if result.err != nil {
+			log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", result.name, region, result.err)
+			errs = multierror.Append(errs, fmt.Errorf("sweeper (%s): %w", result.name, result.err))
+
+			if !allowFailures {
+				failed = true
+				cancel()
+			}
+		}
+
+		dependents, err := g.DirectDependentsOf(result.name)
+
+		// Stop scheduling sweepers but wait for those already running to exit.
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			failed = true
+			cancel()
+
+			continue
+		}
+
+		for _, dependent := range dependents {
+			if pending[dependent]--; pending[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	summary := make([]*sweeperResult, 0, len(order))
+	for _, name := range order {
+		summary = append(summary, results[name])
+	}
+
+	return summary, errs.ErrorOrNil()
+}
+
+// logSummary logs a per-resource type summary of a Region's sweeper results.
+func logSummary(region string, results []*sweeperResult) {
+	var sb strings.Builder
+
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "RESOURCE TYPE\tFOUND\tDELETED\tSKIPPED\tFAILED\tSTATUS")
+
+	for _, result := range results {
+		status := "ok"
+
+		switch {
+		case !result.ran:
+			status = "not run"
+		case result.err != nil:
+			status = "error"
+		case result.skipped:
+			status = "skipped"
+		}
+
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%s\n", result.name, result.stats.found.Load(), result.stats.deleted.Load(), result.stats.skipped.Load(), result.stats.failed.Load(), status)
+	}
+
+	w.Flush()
+
+	log.Printf("Sweeper summary for region (%s):\n%s", region, sb.String())
+}
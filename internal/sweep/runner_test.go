@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
+)
+
+type mockSweepable struct {
+	err     error
+	deleted atomic.Bool
+}
+
+func (m *mockSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	m.deleted.Store(true)
+
+	return m.err
+}
+
+// sweeperRecorder records the order in which sweepers complete and the maximum number running concurrently.
+type sweeperRecorder struct {
+	mu          sync.Mutex
+	completed   []string
+	running     int
+	maxRunning  int
+	sweepDelay  time.Duration
+	sweepErrors map[string]error
+}
+
+func (r *sweeperRecorder) sweeper(name string, dependencies ...string) *Sweeper {
+	return &Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		F: func(ctx context.Context, region string) error {
+			r.mu.Lock()
+			r.running++
+			if r.running > r.maxRunning {
+				r.maxRunning = r.running
+			}
+			r.mu.Unlock()
+
+			time.Sleep(r.sweepDelay)
+
+			r.mu.Lock()
+			r.running--
+			r.completed = append(r.completed, name)
+			r.mu.Unlock()
+
+			return r.sweepErrors[name]
+		},
+	}
+}
+
+func sweeperMap(sweepers ...*Sweeper) map[string]*Sweeper {
+	m := make(map[string]*Sweeper, len(sweepers))
+
+	for _, s := range sweepers {
+		m[s.Name] = s
+	}
+
+	return m
+}
+
+func TestSweeperGraphCycle(t *testing.T) {
+	t.Parallel()
+
+	r := &sweeperRecorder{}
+	sweepers := sweeperMap(
+		r.sweeper("aws_a", "aws_b"),
+		r.sweeper("aws_b", "aws_c"),
+		r.sweeper("aws_c", "aws_a"),
+	)
+
+	if _, err := runSweepers(context.Background(), []string{"us-west-2"}, sweepers, false, 1); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if len(r.completed) != 0 {
+		t.Errorf("sweepers ran: %v", r.completed)
+	}
+}
+
+func TestRunSweepersDependencyOrder(t *testing.T) {
+	t.Parallel()
+
+	r := &sweeperRecorder{sweepDelay: 10 * time.Millisecond}
+	sweepers := sweeperMap(
+		r.sweeper("aws_vpc", "aws_subnet", "aws_internet_gateway"),
+		r.sweeper("aws_subnet", "aws_instance"),
+		r.sweeper("aws_internet_gateway"),
+		r.sweeper("aws_instance"),
+		r.sweeper("aws_s3_bucket"),
+		r.sweeper("aws_iam_role", "aws_missing"),
+	)
+
+	if _, err := runSweepers(context.Background(), []string{"us-west-2"}, sweepers, false, 2); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(r.completed), len(sweepers); got != want {
+		t.Fatalf("%d sweepers completed, want %d", got, want)
+	}
+
+	for name, s := range sweepers {
+		for _, dependency := range s.Dependencies {
+			if !slices.Contains(r.completed, dependency) {
+				continue
+			}
+
+			if slices.Index(r.completed, dependency) > slices.Index(r.completed, name) {
+				t.Errorf("sweeper %s completed before its dependency %s: %v", name, dependency, r.completed)
+			}
+		}
+	}
+
+	if r.maxRunning > 2 {
+		t.Errorf("%d sweepers ran concurrently, want at most 2", r.maxRunning)
+	}
+	if r.maxRunning < 2 {
+		t.Errorf("sweepers ran sequentially, want concurrently")
+	}
+}
+
+func TestRunSweepersFailure(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		allowFailures bool
+		wantCompleted []string
+	}{
+		"stop": {
+			wantCompleted: []string{"aws_instance"},
+		},
+		"allow failures": {
+			allowFailures: true,
+			wantCompleted: []string{"aws_instance", "aws_subnet"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &sweeperRecorder{sweepErrors: map[string]error{"aws_instance": errors.New("test")}}
+			sweepers := sweeperMap(
+				r.sweeper("aws_subnet", "aws_instance"),
+				r.sweeper("aws_instance"),
+			)
+
+			results, err := runSweepers(context.Background(), []string{"us-west-2", "us-east-1"}, sweepers, testCase.allowFailures, 1)
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if diff := cmp.Diff(r.completed[:len(testCase.wantCompleted)], testCase.wantCompleted); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if _, ok := results["us-east-1"]; ok != testCase.allowFailures {
+				t.Errorf("us-east-1 swept: %t, want %t", ok, testCase.allowFailures)
+			}
+		})
+	}
+}
+
+func TestRunSweepersStats(t *testing.T) {
+	t.Parallel()
+
+	sweepers := sweeperMap(&Sweeper{
+		Name: "aws_example_thing",
+		F: func(ctx context.Context, region string) error {
+			return SweepOrchestrator(ctx, []Sweepable{
+				&mockSweepable{},
+				&mockSweepable{err: errors.New("test")},
+				&mockSweepable{},
+			})
+		},
+	})
+
+	results, err := runSweepers(context.Background(), []string{"us-west-2"}, sweepers, true, 1)
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	result := results["us-west-2"][0]
+
+	if !result.ran {
+		t.Fatal("sweeper did not run")
+	}
+
+	got := []int64{result.stats.found.Load(), result.stats.deleted.Load(), result.stats.skipped.Load(), result.stats.failed.Load()}
+	want := []int64{3, 2, 0, 1}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	t.Parallel()
+
+	r := &sweeperRecorder{}
+	sweepers := sweeperMap(
+		r.sweeper("aws_vpc", "aws_subnet"),
+		r.sweeper("aws_subnet", "aws_instance"),
+		r.sweeper("aws_instance"),
+		r.sweeper("aws_s3_bucket"),
+	)
+
+	testCases := map[string]struct {
+		filter string
+		want   []string
+	}{
+		"empty": {
+			want: []string{"aws_instance", "aws_s3_bucket", "aws_subnet", "aws_vpc"},
+		},
+		"dependencies": {
+			filter: "aws_vpc",
+			want:   []string{"aws_instance", "aws_subnet", "aws_vpc"},
+		},
+		"multiple": {
+			filter: "INSTANCE,s3",
+			want:   []string{"aws_instance", "aws_s3_bucket"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := make([]string, 0)
+			for name := range filterSweepers(testCase.filter, sweepers) {
+				got = append(got, name)
+			}
+			slices.Sort(got)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSweepersOperationNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	sweepers := sweeperMap(&Sweeper{
+		Name: "aws_example_thing",
+		F: func(ctx context.Context, region string) error {
+			return checkOperation(ctx, "DeleteThing")
+		},
+	})
+	ctx := withOptions(context.Background(), options{dryRun: true})
+
+	results, err := runSweepers(ctx, []string{"us-west-2"}, sweepers, false, 1)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if result := results["us-west-2"][0]; !result.skipped {
+		t.Error("sweeper not skipped")
+	}
+}
@@ -31,6 +31,11 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+// String returns the resource's ID.
+func (sr *sweepResource) String() string {
+	return sr.d.Id()
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
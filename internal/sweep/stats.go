@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// stats counts the resources handled by a single sweeper.
+type stats struct {
+	resourceType string
+
+	// filtered is set once the sweeper has consulted the run's Filter.
+	filtered atomic.Bool
+
+	found   atomic.Int64
+	deleted atomic.Int64
+	skipped atomic.Int64
+	failed  atomic.Int64
+}
+
+type statsKey struct{}
+
+// withStats returns a new Context that carries the specified sweeper statistics.
+func withStats(ctx context.Context, stats *stats) context.Context {
+	return context.WithValue(ctx, statsKey{}, stats)
+}
+
+// statsFromContext returns the sweeper statistics carried by the Context.
+// Statistics are discarded if the Context carries none.
+func statsFromContext(ctx context.Context) *stats {
+	if v, ok := ctx.Value(statsKey{}).(*stats); ok {
+		return v
+	}
+
+	return &stats{}
+}
@@ -6,11 +6,13 @@ package sweep
 import (
 	"context"
 	"fmt"
+	"log"
 	"os"
 	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
@@ -65,24 +67,28 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := &awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []*awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -92,6 +98,8 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
+	guardClient(client)
+
 	sweeperClients[region] = client
 
 	return client, nil
@@ -101,20 +109,66 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator concurrently deletes the specified resources.
+// The outcome for each resource is recorded in the sweeper statistics carried by the Context.
+// During a dry run the resources are listed but not deleted.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	var g multierror.Group
+	opts := optionsFromContext(ctx)
+	stats := statsFromContext(ctx)
+
+	stats.found.Add(int64(len(sweepables)))
+
+	if opts.dryRun {
+		for _, sweepable := range sweepables {
+			log.Printf("[INFO] Dry run: would delete %s %s", stats.resourceType, describe(sweepable))
+		}
+		stats.skipped.Add(int64(len(sweepables)))
+
+		return nil
+	}
+
+	if !opts.filter.isEmpty() && !stats.filtered.Load() {
+		log.Printf("[WARN] Skipping %s: sweeper does not support filtering", stats.resourceType)
+		stats.skipped.Add(int64(len(sweepables)))
+
+		return nil
+	}
+
+	ctx = withDeleteAllowed(ctx)
 
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			// The sweeper run has been abandoned.
+			if ctx.Err() != nil {
+				stats.skipped.Add(1)
+				return nil
+			}
+
+			if err := sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...); err != nil {
+				stats.failed.Add(1)
+				return err
+			}
+
+			stats.deleted.Add(1)
+			return nil
 		})
 	}
 
 	return g.Wait().ErrorOrNil()
 }
 
+// describe returns a description of the specified resource for logging.
+func describe(sweepable Sweepable) string {
+	if v, ok := sweepable.(fmt.Stringer); ok {
+		return v.String()
+	}
+
+	return fmt.Sprintf("%T", sweepable)
+}
+
 // Deprecated: Usse awsv1.SkipSweepError
 //
 //nolint:stylecheck // It's not required for functions, so why for variables?
@@ -6,7 +6,6 @@ import (
 	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/acm"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
@@ -158,5 +157,5 @@ import (
 
 func TestMain(m *testing.M) {
 	sweep.ServicePackages = servicePackages(context.Background())
-	resource.TestMain(m)
+	sweep.TestMain(m)
 }
@@ -17,6 +17,8 @@ type InContext struct {
 	TagsIn types.Option[KeyValueTags]
 	// TagsOut holds tags returned from AWS, including any ignored or system tags.
 	TagsOut types.Option[KeyValueTags]
+	// TagsOnlyUpdate is set if the only changes in an update were to tags and those changes have already been made.
+	TagsOnlyUpdate bool
 }
 
 // NewContext returns a Context enhanced with tagging information.
@@ -36,6 +38,16 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	return v, ok
 }
 
+// DefaultConfigFromContext returns the default tags configuration that applies to the resource in Context.
+// If Context has no tagging information the specified provider-level configuration is returned.
+func DefaultConfigFromContext(ctx context.Context, defaultConfig *DefaultConfig) *DefaultConfig {
+	if v, ok := FromContext(ctx); ok {
+		return v.DefaultConfig
+	}
+
+	return defaultConfig
+}
+
 type keyType int
 
 var tagKey keyType
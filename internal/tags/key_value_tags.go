@@ -33,6 +33,8 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// ExcludeResourceTypes lists the resource types, e.g. "aws_autoscaling_group", to which the tags are not applied.
+	ExcludeResourceTypes []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -47,6 +49,22 @@ type IgnoreConfig struct {
 // across all these Go types, we convert them into this Go type.
 type KeyValueTags map[string]*TagData
 
+// ForResourceType returns the DefaultConfig that applies to the specified resource type.
+// nil is returned if the resource type is excluded from default tagging.
+func (dc *DefaultConfig) ForResourceType(typeName string) *DefaultConfig {
+	if dc == nil {
+		return nil
+	}
+
+	for _, v := range dc.ExcludeResourceTypes {
+		if v == typeName {
+			return nil
+		}
+	}
+
+	return dc
+}
+
 // GetTags is convenience method that returns the DefaultConfig's Tags, if any
 func (dc *DefaultConfig) GetTags() KeyValueTags {
 	if dc == nil {
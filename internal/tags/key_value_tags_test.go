@@ -57,6 +57,90 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"key1": "value1",
+		}),
+		ExcludeResourceTypes: []string{"aws_autoscaling_group", "aws_vpc_security_group_ingress_rule"},
+	}
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		want          KeyValueTags
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			typeName:      "aws_instance",
+			want:          nil,
+		},
+		{
+			name:          "SDK resource not excluded",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_instance",
+			want: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name:          "SDK resource excluded",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_autoscaling_group",
+			want:          nil,
+		},
+		{
+			name:          "Framework resource excluded",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_vpc_security_group_ingress_rule",
+			want:          nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResourceType(testCase.typeName).GetTags()
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want.Map())
+		})
+	}
+}
+
+func TestDefaultConfigFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"key1": "value1",
+		}),
+		ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+	}
+
+	if got := DefaultConfigFromContext(ctx, defaultConfig); got != defaultConfig {
+		t.Errorf("DefaultConfigFromContext without tagging information = %v, want %v", got, defaultConfig)
+	}
+
+	excludedCtx := NewContext(ctx, defaultConfig.ForResourceType("aws_autoscaling_group"), nil)
+
+	if got := DefaultConfigFromContext(excludedCtx, defaultConfig); got != nil {
+		t.Errorf("DefaultConfigFromContext for excluded resource type = %v, want nil", got)
+	}
+
+	includedCtx := NewContext(ctx, defaultConfig.ForResourceType("aws_instance"), nil)
+
+	if got := DefaultConfigFromContext(includedCtx, defaultConfig); got != defaultConfig {
+		t.Errorf("DefaultConfigFromContext for included resource type = %v, want %v", got, defaultConfig)
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
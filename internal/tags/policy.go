@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	PolicyComplianceDisabled = "disabled"
+	PolicyComplianceError    = "error"
+	PolicyComplianceWarning  = "warning"
+)
+
+// PolicyComplianceValues returns the valid values for the provider's tag_policy_compliance setting.
+func PolicyComplianceValues() []string {
+	return []string{
+		PolicyComplianceDisabled,
+		PolicyComplianceError,
+		PolicyComplianceWarning,
+	}
+}
+
+// Policy is an AWS Organizations effective tag policy.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+type Policy struct {
+	// keys maps lowercase tag keys to the policy for that key.
+	keys map[string]policyKey
+}
+
+type policyKey struct {
+	key    string   // Required capitalization
+	values []string // Allowed values; empty means any value is allowed
+}
+
+// PolicyViolation describes a tag that does not comply with a tag policy.
+type PolicyViolation struct {
+	Key     string
+	Message string
+}
+
+func (v PolicyViolation) Error() string {
+	return v.Message
+}
+
+// NewPolicy parses the content of an effective tag policy.
+func NewPolicy(content string) (*Policy, error) {
+	var document struct {
+		Tags map[string]struct {
+			TagKey *struct {
+				Assign string `json:"@@assign"`
+			} `json:"tag_key"`
+			TagValue *struct {
+				Assign []string `json:"@@assign"`
+			} `json:"tag_value"`
+		} `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return nil, fmt.Errorf("parsing tag policy: %w", err)
+	}
+
+	policy := &Policy{
+		keys: make(map[string]policyKey, len(document.Tags)),
+	}
+
+	for k, v := range document.Tags {
+		pk := policyKey{
+			key: k,
+		}
+
+		if v.TagKey != nil && v.TagKey.Assign != "" {
+			pk.key = v.TagKey.Assign
+		}
+
+		if v.TagValue != nil {
+			pk.values = v.TagValue.Assign
+		}
+
+		policy.keys[strings.ToLower(k)] = pk
+	}
+
+	return policy, nil
+}
+
+// Violations returns the tags that do not comply with the tag policy, ordered by key.
+// A tag complies if its key has the capitalization required by the policy and its value is one of the allowed values.
+// Allowed values ending in `*` match any value with that prefix.
+func (p *Policy) Violations(tags KeyValueTags) []PolicyViolation {
+	if p == nil {
+		return nil
+	}
+
+	var violations []PolicyViolation
+
+	for _, k := range tags.Keys() {
+		pk, ok := p.keys[strings.ToLower(k)]
+
+		if !ok {
+			continue
+		}
+
+		if k != pk.key {
+			violations = append(violations, PolicyViolation{
+				Key:     k,
+				Message: fmt.Sprintf("tag key %q does not match the capitalization %q required by tag policy", k, pk.key),
+			})
+
+			continue
+		}
+
+		if v := tags.KeyValue(k); v != nil && !pk.allowed(*v) {
+			violations = append(violations, PolicyViolation{
+				Key:     k,
+				Message: fmt.Sprintf("tag %q value %q is not allowed by tag policy (allowed values: %s)", k, *v, strings.Join(pk.values, ", ")),
+			})
+		}
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Key < violations[j].Key
+	})
+
+	return violations
+}
+
+func (pk policyKey) allowed(value string) bool {
+	if len(pk.values) == 0 {
+		return true
+	}
+
+	for _, v := range pk.values {
+		if prefix, ok := strings.CutSuffix(v, "*"); ok {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+		} else if value == v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+const testPolicyContent = `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200", "team-*"]},
+      "enforced_for": {"@@assign": ["ec2:instance"]}
+    },
+    "project": {
+      "tag_key": {"@@assign": "Project"}
+    }
+  }
+}`
+
+func TestPolicyViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy, err := NewPolicy(testPolicyContent)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want []string
+	}{
+		{
+			name: "compliant",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "100",
+				"Project":    "anything",
+				"Other":      "value",
+			}),
+		},
+		{
+			name: "wildcard value",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "team-blue",
+			}),
+		},
+		{
+			name: "key capitalization",
+			tags: New(ctx, map[string]string{
+				"costcenter": "100",
+				"PROJECT":    "x",
+			}),
+			want: []string{"PROJECT", "costcenter"},
+		},
+		{
+			name: "value not allowed",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "300",
+			}),
+			want: []string{"CostCenter"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := policy.Violations(testCase.tags)
+
+			if len(got) != len(testCase.want) {
+				t.Fatalf("got %d violations (%v), want %d", len(got), got, len(testCase.want))
+			}
+
+			for i, v := range got {
+				if v.Key != testCase.want[i] {
+					t.Errorf("violation %d: got key %q, want %q", i, v.Key, testCase.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyViolationsNilPolicy(t *testing.T) {
+	t.Parallel()
+
+	var policy *Policy
+
+	if got := policy.Violations(New(context.Background(), map[string]string{"key": "value"})); len(got) != 0 {
+		t.Errorf("got %d violations, want none", len(got))
+	}
+}
+
+func TestNewPolicyInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewPolicy(`{"tags":`); err == nil {
+		t.Error("expected error, got none")
+	}
+}
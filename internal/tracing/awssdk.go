@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type apiSpanKey struct{}
+
+func startAPISpan(ctx context.Context, service, operation, region string) (context.Context, trace.Span) {
+	return tracer().Start(ctx, service+"."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			AttrAWSService.String(service),
+			AttrAWSOperation.String(operation),
+			AttrAWSRegion.String(region),
+		),
+	)
+}
+
+// InstrumentAWSSDKv1Handlers adds handlers that trace each AWS SDK for Go v1 API request, including all of its retries.
+func InstrumentAWSSDKv1Handlers(handlers *request_sdkv1.Handlers) {
+	handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "TracingStart",
+		Fn: func(r *request_sdkv1.Request) {
+			ctx, span := startAPISpan(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name, aws_sdkv1.StringValue(r.Config.Region))
+			r.SetContext(context.WithValue(ctx, apiSpanKey{}, span))
+		},
+	})
+	handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "TracingEnd",
+		Fn: func(r *request_sdkv1.Request) {
+			// Only end the span started by this request, never an enclosing span.
+			span, ok := r.Context().Value(apiSpanKey{}).(trace.Span)
+			if !ok {
+				return
+			}
+
+			attrs := []attribute.KeyValue{
+				AttrAWSRetries.Int(r.RetryCount),
+			}
+			if r.RequestID != "" {
+				attrs = append(attrs, AttrAWSRequestID.String(r.RequestID))
+			}
+			if r.HTTPResponse != nil {
+				attrs = append(attrs, AttrHTTPStatusCode.Int(r.HTTPResponse.StatusCode))
+			}
+			var awsErr awserr.Error
+			if errors.As(r.Error, &awsErr) {
+				attrs = append(attrs, AttrAWSErrorCode.String(awsErr.Code()))
+			}
+			span.SetAttributes(attrs...)
+
+			setError(span, r.Error)
+
+			span.End()
+		},
+	})
+}
+
+// InstrumentAWSSDKv2Config adds middleware that traces each AWS SDK for Go v2 API request, including all of its retries.
+func InstrumentAWSSDKv2Config(cfg *aws_sdkv2.Config) {
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+		// Initialize middleware added After runs once the operation's service metadata is in Context and before the retry middleware.
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("Tracing", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			ctx, span := startAPISpan(ctx, awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), awsmiddleware.GetRegion(ctx))
+			defer span.End()
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			var attrs []attribute.KeyValue
+			if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+				attrs = append(attrs, AttrAWSRetries.Int(len(v.Results)-1))
+			}
+			if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+				attrs = append(attrs, AttrAWSRequestID.String(v))
+			}
+			if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+				attrs = append(attrs, AttrHTTPStatusCode.Int(v.StatusCode))
+			}
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) {
+				attrs = append(attrs, AttrAWSErrorCode.String(apiErr.ErrorCode()))
+			}
+			span.SetAttributes(attrs...)
+
+			setError(span, err)
+
+			return out, metadata, err
+		}), middleware.After)
+	})
+}
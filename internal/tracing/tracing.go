@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tracing implements optional OpenTelemetry tracing of provider operations and AWS API requests.
+//
+// Tracing is enabled by setting the TF_AWS_TRACING environment variable:
+//
+//   - "otlp" exports spans to an OTLP/HTTP collector, configured with the standard OTEL_EXPORTER_OTLP_* environment variables
+//     (by default http://localhost:4318)
+//   - "file" writes spans as JSON to the file named by TF_AWS_TRACING_FILE (by default terraform-provider-aws-traces.json)
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultTracingFile  = "terraform-provider-aws-traces.json"
+	instrumentationName = "github.com/hashicorp/terraform-provider-aws"
+)
+
+// Span attribute keys.
+const (
+	AttrAWSErrorCode   = attribute.Key("tf_aws.aws.error_code")
+	AttrAWSOperation   = attribute.Key("tf_aws.aws.operation")
+	AttrAWSRegion      = attribute.Key("tf_aws.aws.region")
+	AttrAWSRequestID   = attribute.Key("tf_aws.aws.request_id")
+	AttrAWSRetries     = attribute.Key("tf_aws.aws.retries")
+	AttrAWSService     = attribute.Key("tf_aws.aws.service")
+	AttrHTTPStatusCode = attribute.Key("tf_aws.http.status_code")
+	AttrIsDataSource   = attribute.Key("tf_aws.resource.data_source")
+	AttrResourceID     = attribute.Key("tf_aws.resource.id")
+	AttrResourceOp     = attribute.Key("tf_aws.resource.operation")
+	AttrResourceType   = attribute.Key("tf_aws.resource.type")
+	AttrServicePackage = attribute.Key("tf_aws.service_package")
+)
+
+var enabled bool
+
+// Enabled returns whether tracing has been started.
+func Enabled() bool {
+	return enabled
+}
+
+// Start configures the global OpenTelemetry tracer provider as specified by environment variables.
+// The returned function flushes any buffered spans and must be called before the provider exits.
+// If tracing isn't enabled then Start is a no-op.
+func Start(ctx context.Context) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	var opt sdktrace.TracerProviderOption
+	var closeFile func() error
+
+	switch v := os.Getenv(envvar.Tracing); v {
+	case "":
+		return noop, nil
+	case "otlp":
+		exporter, err := otlptracehttp.New(ctx)
+
+		if err != nil {
+			return noop, fmt.Errorf("creating OTLP trace exporter: %w", err)
+		}
+
+		opt = sdktrace.WithBatcher(exporter)
+	case "file":
+		filename := os.Getenv(envvar.TracingFile)
+		if filename == "" {
+			filename = defaultTracingFile
+		}
+
+		f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+		if err != nil {
+			return noop, fmt.Errorf("opening trace file (%s): %w", filename, err)
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+
+		if err != nil {
+			f.Close()
+			return noop, fmt.Errorf("creating file trace exporter: %w", err)
+		}
+
+		// Spans are written synchronously so that none are lost if the provider process is killed.
+		opt = sdktrace.WithSyncer(exporter)
+		closeFile = f.Close
+	default:
+		return noop, fmt.Errorf("invalid value for %s (%s), expected otlp or file", envvar.Tracing, v)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		opt,
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", "terraform-provider-aws"),
+			attribute.String("service.version", version.ProviderVersion),
+		)),
+	)
+
+	otel.SetTracerProvider(tp)
+	enabled = true
+
+	return func(ctx context.Context) error {
+		err := tp.Shutdown(ctx)
+
+		if closeFile != nil {
+			if e := closeFile(); err == nil {
+				err = e
+			}
+		}
+
+		return err
+	}, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartHandlerSpan starts a span for a data source or resource CRUD handler.
+func StartHandlerSpan(ctx context.Context, servicePackageName, typeName string, isDataSource bool, operation string) (context.Context, trace.Span) {
+	name := typeName + "." + operation
+	if isDataSource {
+		name = "data." + name
+	}
+
+	return tracer().Start(ctx, name, trace.WithAttributes(
+		AttrServicePackage.String(servicePackageName),
+		AttrResourceType.String(typeName),
+		AttrIsDataSource.Bool(isDataSource),
+		AttrResourceOp.String(operation),
+	))
+}
+
+// EndHandlerSpan annotates the specified CRUD handler span with the resource's ID and any error, and ends the span.
+func EndHandlerSpan(span trace.Span, id string, err error) {
+	if id != "" {
+		span.SetAttributes(AttrResourceID.String(id))
+	}
+
+	setError(span, err)
+
+	span.End()
+}
+
+func setError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+	})
+
+	return recorder
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value)
+	for _, v := range span.Attributes() {
+		m[v.Key] = v.Value
+	}
+	return m
+}
+
+func TestStartInvalid(t *testing.T) {
+	t.Setenv(envvar.Tracing, "invalid")
+
+	if _, err := Start(context.Background()); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestHandlerSpan(t *testing.T) {
+	recorder := newTestSpanRecorder(t)
+
+	_, span := StartHandlerSpan(context.Background(), "ec2", "aws_subnet", false, "Create")
+	EndHandlerSpan(span, "subnet-12345678", nil)
+
+	_, span = StartHandlerSpan(context.Background(), "ec2", "aws_subnet", true, "Read")
+	EndHandlerSpan(span, "", errors.New("test error"))
+
+	spans := recorder.Ended()
+	if got, want := len(spans), 2; got != want {
+		t.Fatalf("got %d spans, want %d", got, want)
+	}
+
+	if got, want := spans[0].Name(), "aws_subnet.Create"; got != want {
+		t.Errorf("got span name %q, want %q", got, want)
+	}
+	attrs := spanAttributes(spans[0])
+	if got, want := attrs[AttrResourceID].AsString(), "subnet-12345678"; got != want {
+		t.Errorf("got resource ID %q, want %q", got, want)
+	}
+	if got, want := attrs[AttrResourceType].AsString(), "aws_subnet"; got != want {
+		t.Errorf("got resource type %q, want %q", got, want)
+	}
+	if got, want := spans[0].Status().Code, codes.Unset; got != want {
+		t.Errorf("got status %s, want %s", got, want)
+	}
+
+	if got, want := spans[1].Name(), "data.aws_subnet.Read"; got != want {
+		t.Errorf("got span name %q, want %q", got, want)
+	}
+	if got, want := spans[1].Status().Code, codes.Error; got != want {
+		t.Errorf("got status %s, want %s", got, want)
+	}
+}
+
+func TestInstrumentAWSSDKv1Handlers(t *testing.T) {
+	recorder := newTestSpanRecorder(t)
+
+	var handlers request_sdkv1.Handlers
+	InstrumentAWSSDKv1Handlers(&handlers)
+	handlers.Send.PushBack(func(r *request_sdkv1.Request) {
+		r.RequestID = "request-1"
+		r.Error = awserr.New("ThrottlingException", "Rate exceeded", nil)
+	})
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	r := request_sdkv1.New(
+		aws_sdkv1.Config{Region: aws_sdkv1.String("us-west-2")}, //lintignore:AWSAT003
+		metadata.ClientInfo{ServiceID: "EC2"},
+		handlers,
+		nil,
+		&request_sdkv1.Operation{Name: "DescribeSubnets"},
+		nil,
+		nil,
+	)
+	r.SetContext(ctx)
+
+	if err := r.Send(); err == nil {
+		t.Fatal("expected error")
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	if got, want := len(spans), 2; got != want {
+		t.Fatalf("got %d spans, want %d", got, want)
+	}
+
+	span := spans[0]
+	if got, want := span.Name(), "EC2.DescribeSubnets"; got != want {
+		t.Errorf("got span name %q, want %q", got, want)
+	}
+	if got, want := span.Parent().SpanID(), parent.SpanContext().SpanID(); got != want {
+		t.Errorf("got parent span %s, want %s", got, want)
+	}
+	attrs := spanAttributes(span)
+	if got, want := attrs[AttrAWSErrorCode].AsString(), "ThrottlingException"; got != want {
+		t.Errorf("got error code %q, want %q", got, want)
+	}
+	if got, want := attrs[AttrAWSRequestID].AsString(), "request-1"; got != want {
+		t.Errorf("got request ID %q, want %q", got, want)
+	}
+	if got, want := span.Status().Code, codes.Error; got != want {
+		t.Errorf("got status %s, want %s", got, want)
+	}
+}
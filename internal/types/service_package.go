@@ -15,6 +15,25 @@ import (
 type ServicePackageResourceTags struct {
 	IdentifierAttribute string // The attribute for the identifier for UpdateTags etc.
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
+	AlwaysUpdate        bool   // Call the resource's Update handler even if only tags have changed
+}
+
+// ListResult represents an existing resource returned by a list request.
+type ListResult struct {
+	DisplayName string // Human-readable name of the resource
+	ImportID    string // The value that can be passed to `terraform import`
+}
+
+// ListResourceFunc enumerates existing resources of a single resource type.
+// The supported filter keys are specific to each resource type.
+type ListResourceFunc func(ctx context.Context, meta any, filters map[string]string) ([]ListResult, error)
+
+// ServicePackageListResource represents a list capability for a resource type
+// implemented by a service package.
+type ServicePackageListResource struct {
+	List     ListResourceFunc
+	TypeName string
+	Name     string
 }
 
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
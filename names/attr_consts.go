@@ -10,6 +10,7 @@ const (
 	AttrID          = "id" // Should be explicitly declared only for Framework resources
 	AttrKMSKeyARN   = "kms_key_arn"
 	AttrName        = "name"
+	AttrRegion      = "region"
 	AttrTags        = "tags"
 	AttrTagsAll     = "tags_all"
 	AttrTimeouts    = "timeouts" // Should be explicitly declared only for Framework resources
@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package apimodel introspects the API model of an AWS SDK for Go v2 service package.
+// Operation input and output structures, and the nested structures and enums in the
+// service's types package, are described by Go types and doc comments.
+package apimodel
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/constant"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// ServicePackagePathPrefix is the import path prefix of AWS SDK for Go v2 service packages.
+	ServicePackagePathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+
+	requiredMemberDoc = "This member is required."
+)
+
+// Kind is the kind of an API model type.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindBool
+	KindEnum
+	KindFloat
+	KindInt
+	KindList
+	KindMap
+	KindString
+	KindStruct
+	KindTimestamp
+)
+
+// Type describes the type of a structure member.
+type Type struct {
+	Kind   Kind
+	Name   string      // Name of an enum or structure type in the service's types package.
+	Elem   *Type       // Element type of a list or map.
+	Struct *Struct     // Structure members.
+	Values []EnumValue // Enum values.
+}
+
+// EnumValue is a single value of an enum.
+type EnumValue struct {
+	Name  string // Go constant name, e.g. "RuleStatusAvailable".
+	Value string // Wire value, e.g. "available".
+}
+
+// Field describes a structure member.
+type Field struct {
+	Name     string
+	Doc      string
+	Required bool
+	Pointer  bool // Whether the Go field is a pointer, e.g. *string.
+	Type     *Type
+}
+
+// Struct describes an operation input or output, or a structure in the service's types package.
+type Struct struct {
+	Name   string
+	Fields []*Field
+}
+
+// Field returns the named structure member, or nil if there is no such member.
+func (s *Struct) Field(name string) *Field {
+	if s == nil {
+		return nil
+	}
+
+	for _, v := range s.Fields {
+		if v.Name == name {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// Operation describes an API operation.
+type Operation struct {
+	Name   string
+	Input  *Struct
+	Output *Struct
+}
+
+// Package is a loaded AWS SDK for Go v2 service package.
+type Package struct {
+	Path string // Import path, e.g. "github.com/aws/aws-sdk-go-v2/service/rbin".
+	Name string // Package name, e.g. "rbin".
+
+	pkg      *types.Package
+	typesPkg *types.Package
+	docs     map[string]string // Keyed by "<struct>.<field>".
+	structs  map[string]*Struct
+}
+
+// Load loads the specified service package and its types package.
+// The package may be specified by its import path or by its name, e.g. "rbin".
+// Module versions are resolved relative to the specified directory.
+func Load(dir, path string) (*Package, error) {
+	if !strings.Contains(path, "/") {
+		path = ServicePackagePathPrefix + path
+	}
+
+	p := &Package{
+		Path:    path,
+		docs:    make(map[string]string),
+		structs: make(map[string]*Struct),
+	}
+
+	fset := token.NewFileSet()
+	importer := importer.ForCompiler(fset, "source", nil).(types.ImporterFrom)
+
+	for _, path := range []string{path, path + "/types"} {
+		pkg, err := importer.ImportFrom(path, dir, 0)
+
+		if err != nil {
+			return nil, fmt.Errorf("loading AWS SDK for Go v2 package (%s): %w", path, err)
+		}
+
+		if err := addPackageDocs(fset, p.docs, dir, path); err != nil {
+			return nil, fmt.Errorf("loading AWS SDK for Go v2 package (%s): %w", path, err)
+		}
+
+		if p.pkg == nil {
+			p.Name = pkg.Name()
+			p.pkg = pkg
+		} else {
+			p.typesPkg = pkg
+		}
+	}
+
+	return p, nil
+}
+
+// addPackageDocs records the doc comments of all struct fields declared in the specified package.
+func addPackageDocs(fset *token.FileSet, docs map[string]string, dir, path string) error {
+	bp, err := build.Import(path, dir, 0)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range bp.GoFiles {
+		file, err := parser.ParseFile(fset, filepath.Join(bp.Dir, v), nil, parser.ParseComments)
+
+		if err != nil {
+			return err
+		}
+
+		addFieldDocs(docs, file)
+	}
+
+	return nil
+}
+
+// addFieldDocs records the doc comments of all struct fields declared in the specified file.
+func addFieldDocs(docs map[string]string, file *ast.File) {
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.TypeSpec)
+			st, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+
+			for _, field := range st.Fields.List {
+				for _, name := range field.Names {
+					docs[spec.Name.Name+"."+name.Name] = field.Doc.Text()
+				}
+			}
+		}
+	}
+}
+
+// Operation returns the named operation's input and output structures.
+func (p *Package) Operation(name string) (*Operation, error) {
+	input, err := p.operationStruct(name + "Input")
+
+	if err != nil {
+		return nil, fmt.Errorf("operation %s.%s: %w", p.Name, name, err)
+	}
+
+	output, err := p.operationStruct(name + "Output")
+
+	if err != nil {
+		return nil, fmt.Errorf("operation %s.%s: %w", p.Name, name, err)
+	}
+
+	return &Operation{
+		Name:   name,
+		Input:  input,
+		Output: output,
+	}, nil
+}
+
+// HasFunc returns whether the service package declares the named function, e.g. a paginator constructor.
+func (p *Package) HasFunc(name string) bool {
+	_, ok := p.pkg.Scope().Lookup(name).(*types.Func)
+
+	return ok
+}
+
+// HasType returns whether the service's types package declares the named type, e.g. an exception.
+func (p *Package) HasType(name string) bool {
+	_, ok := p.typesPkg.Scope().Lookup(name).(*types.TypeName)
+
+	return ok
+}
+
+func (p *Package) operationStruct(name string) (*Struct, error) {
+	obj, ok := p.pkg.Scope().Lookup(name).(*types.TypeName)
+
+	if !ok {
+		return nil, fmt.Errorf("type %s not found", name)
+	}
+
+	t := p.newType(obj.Type(), nil)
+
+	if t.Kind != KindStruct {
+		return nil, fmt.Errorf("type %s is not a struct", name)
+	}
+
+	return t.Struct, nil
+}
+
+// newType returns the API model type corresponding to the specified Go type.
+// inProgress contains the names of the structures currently being converted and is used to break cycles.
+func (p *Package) newType(t types.Type, inProgress map[string]bool) *Type {
+	switch t := t.(type) {
+	case *types.Pointer:
+		return p.newType(t.Elem(), inProgress)
+
+	case *types.Basic:
+		switch info := t.Info(); {
+		case info&types.IsBoolean != 0:
+			return &Type{Kind: KindBool}
+		case info&types.IsInteger != 0:
+			return &Type{Kind: KindInt}
+		case info&types.IsFloat != 0:
+			return &Type{Kind: KindFloat}
+		case info&types.IsString != 0:
+			return &Type{Kind: KindString}
+		}
+
+	case *types.Slice:
+		if v, ok := t.Elem().(*types.Basic); ok && v.Kind() == types.Byte {
+			return &Type{Kind: KindUnsupported} // Blob.
+		}
+
+		elem := p.newType(t.Elem(), inProgress)
+		if elem.Kind == KindUnsupported || elem.Kind == KindList || elem.Kind == KindMap {
+			return &Type{Kind: KindUnsupported}
+		}
+
+		return &Type{Kind: KindList, Elem: elem}
+
+	case *types.Map:
+		if v, ok := t.Key().(*types.Basic); !ok || v.Kind() != types.String {
+			return &Type{Kind: KindUnsupported}
+		}
+
+		elem := p.newType(t.Elem(), inProgress)
+		if elem.Kind != KindString && elem.Kind != KindEnum {
+			return &Type{Kind: KindUnsupported}
+		}
+
+		return &Type{Kind: KindMap, Elem: elem}
+
+	case *types.Named:
+		obj := t.Obj()
+
+		if obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time" {
+			return &Type{Kind: KindTimestamp}
+		}
+
+		if obj.Pkg() != p.pkg && obj.Pkg() != p.typesPkg {
+			return &Type{Kind: KindUnsupported} // e.g. document.Interface or middleware.Metadata.
+		}
+
+		switch u := t.Underlying().(type) {
+		case *types.Basic:
+			if u.Info()&types.IsString != 0 {
+				return &Type{Kind: KindEnum, Name: obj.Name(), Values: p.enumValues(t)}
+			}
+
+		case *types.Struct:
+			if v, ok := p.structs[obj.Name()]; ok {
+				return &Type{Kind: KindStruct, Name: obj.Name(), Struct: v}
+			}
+
+			if inProgress[obj.Name()] {
+				return &Type{Kind: KindUnsupported} // Recursive structure.
+			}
+
+			return &Type{Kind: KindStruct, Name: obj.Name(), Struct: p.newStruct(obj.Name(), u, inProgress)}
+		}
+	}
+
+	return &Type{Kind: KindUnsupported} // e.g. a union interface.
+}
+
+func (p *Package) newStruct(name string, st *types.Struct, inProgress map[string]bool) *Struct {
+	m := make(map[string]bool, len(inProgress)+1)
+	for k, v := range inProgress {
+		m[k] = v
+	}
+	m[name] = true
+
+	s := &Struct{Name: name}
+
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+
+		if !field.Exported() || field.Name() == "ResultMetadata" {
+			continue
+		}
+
+		doc := p.docs[name+"."+field.Name()]
+		s.Fields = append(s.Fields, &Field{
+			Name:     field.Name(),
+			Doc:      doc,
+			Required: strings.Contains(doc, requiredMemberDoc),
+			Pointer:  isPointer(field.Type()),
+			Type:     p.newType(field.Type(), m),
+		})
+	}
+
+	p.structs[name] = s
+
+	return s
+}
+
+// enumValues returns the values of the specified enum type, sorted by constant name.
+func (p *Package) enumValues(t *types.Named) []EnumValue {
+	var values []EnumValue
+
+	scope := t.Obj().Pkg().Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !types.Identical(c.Type(), t) {
+			continue
+		}
+
+		values = append(values, EnumValue{
+			Name:  c.Name(),
+			Value: constant.StringVal(c.Val()),
+		})
+	}
+
+	return values
+}
+
+func isPointer(t types.Type) bool {
+	_, ok := t.(*types.Pointer)
+
+	return ok
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"testing"
+)
+
+const testTypesSrc = `package types
+
+import "time"
+
+type WidgetStatus string
+
+const (
+	WidgetStatusActive   WidgetStatus = "ACTIVE"
+	WidgetStatusCreating WidgetStatus = "CREATING"
+)
+
+type Widget struct {
+	// The name of the widget.
+	//
+	// This member is required.
+	Name *string
+
+	Children  []Widget
+	CreatedAt *time.Time
+	Labels    map[string]string
+	Size      int32
+	Status    WidgetStatus
+
+	noSmithyDocumentSerde
+}
+
+type noSmithyDocumentSerde struct{}
+
+type ResourceNotFoundException struct{}
+`
+
+const testServiceSrc = `package widgets
+
+import "example.com/widgets/types"
+
+type GetWidgetInput struct {
+	// The name of the widget.
+	//
+	// This member is required.
+	Name *string
+
+	Data []byte
+}
+
+type GetWidgetOutput struct {
+	Widget *types.Widget
+
+	ResultMetadata struct{}
+}
+
+func NewListWidgetsPaginator() {}
+`
+
+type testImporter map[string]*types.Package
+
+func (i testImporter) Import(path string) (*types.Package, error) {
+	if v, ok := i[path]; ok {
+		return v, nil
+	}
+
+	return importer.Default().Import(path)
+}
+
+func newTestPackage(t *testing.T) *Package {
+	t.Helper()
+
+	p := &Package{
+		Path:    "example.com/widgets",
+		Name:    "widgets",
+		docs:    make(map[string]string),
+		structs: make(map[string]*Struct),
+	}
+
+	fset := token.NewFileSet()
+	imports := testImporter{}
+
+	for _, v := range []struct {
+		path string
+		src  string
+		pkg  **types.Package
+	}{
+		{"example.com/widgets/types", testTypesSrc, &p.typesPkg},
+		{"example.com/widgets", testServiceSrc, &p.pkg},
+	} {
+		file, err := parser.ParseFile(fset, v.path+".go", v.src, parser.ParseComments)
+		if err != nil {
+			t.Fatalf("parsing %s: %s", v.path, err)
+		}
+
+		conf := types.Config{Importer: imports}
+		pkg, err := conf.Check(v.path, fset, []*ast.File{file}, nil)
+		if err != nil {
+			t.Fatalf("type-checking %s: %s", v.path, err)
+		}
+
+		addFieldDocs(p.docs, file)
+		imports[v.path] = pkg
+		*v.pkg = pkg
+	}
+
+	return p
+}
+
+func TestOperation(t *testing.T) {
+	t.Parallel()
+
+	p := newTestPackage(t)
+
+	op, err := p.Operation("GetWidget")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := op.Input.Name, "GetWidgetInput"; got != expected {
+		t.Errorf("Input.Name: got %s, expected %s", got, expected)
+	}
+
+	name := op.Input.Field("Name")
+	if name == nil || name.Type.Kind != KindString || !name.Required || !name.Pointer {
+		t.Errorf("Input.Name: got %+v, expected required *string", name)
+	}
+
+	if got := op.Input.Field("Data").Type.Kind; got != KindUnsupported {
+		t.Errorf("Input.Data: got kind %d, expected unsupported", got)
+	}
+
+	if got := op.Output.Field("ResultMetadata"); got != nil {
+		t.Errorf("Output.ResultMetadata: got %+v, expected nil", got)
+	}
+
+	widget := op.Output.Field("Widget").Type
+	if widget.Kind != KindStruct || widget.Name != "Widget" {
+		t.Fatalf("Output.Widget: got %+v, expected Widget struct", widget)
+	}
+
+	var fieldNames []string
+	for _, v := range widget.Struct.Fields {
+		fieldNames = append(fieldNames, v.Name)
+	}
+	if expected := []string{"Name", "Children", "CreatedAt", "Labels", "Size", "Status"}; !reflect.DeepEqual(fieldNames, expected) {
+		t.Errorf("Widget fields: got %v, expected %v", fieldNames, expected)
+	}
+
+	testCases := []struct {
+		TestName string
+		Field    string
+		Expected Kind
+	}{
+		{
+			TestName: "recursive list",
+			Field:    "Children",
+			Expected: KindUnsupported,
+		},
+		{
+			TestName: "timestamp",
+			Field:    "CreatedAt",
+			Expected: KindTimestamp,
+		},
+		{
+			TestName: "map",
+			Field:    "Labels",
+			Expected: KindMap,
+		},
+		{
+			TestName: "int",
+			Field:    "Size",
+			Expected: KindInt,
+		},
+		{
+			TestName: "enum",
+			Field:    "Status",
+			Expected: KindEnum,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := widget.Struct.Field(testCase.Field).Type.Kind; got != testCase.Expected {
+				t.Errorf("got kind %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+
+	expectedValues := []EnumValue{
+		{Name: "WidgetStatusActive", Value: "ACTIVE"},
+		{Name: "WidgetStatusCreating", Value: "CREATING"},
+	}
+	if got := widget.Struct.Field("Status").Type.Values; !reflect.DeepEqual(got, expectedValues) {
+		t.Errorf("Status values: got %v, expected %v", got, expectedValues)
+	}
+
+	if _, err := p.Operation("DeleteWidget"); err == nil {
+		t.Errorf("DeleteWidget: expected error, got none")
+	}
+}
+
+func TestHasFuncHasType(t *testing.T) {
+	t.Parallel()
+
+	p := newTestPackage(t)
+
+	if !p.HasFunc("NewListWidgetsPaginator") {
+		t.Errorf("HasFunc(NewListWidgetsPaginator): got false, expected true")
+	}
+	if p.HasFunc("GetWidgetInput") {
+		t.Errorf("HasFunc(GetWidgetInput): got true, expected false")
+	}
+	if !p.HasType("ResourceNotFoundException") {
+		t.Errorf("HasType(ResourceNotFoundException): got false, expected true")
+	}
+	if p.HasType("NotFoundException") {
+		t.Errorf("HasType(NotFoundException): got true, expected false")
+	}
+}
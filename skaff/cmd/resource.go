@@ -16,12 +16,31 @@ var (
 	v1              bool
 	pluginFramework bool
 	includeTags     bool
+
+	sdkPackage      string
+	createOperation string
+	readOperation   string
+	updateOperation string
+	deleteOperation string
+	listOperation   string
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if createOperation != "" {
+			ops := resource.APIModelOperations{
+				Create: createOperation,
+				Read:   readOperation,
+				Update: updateOperation,
+				Delete: deleteOperation,
+				List:   listOperation,
+			}
+
+			return resource.CreateFromAPIModel(name, snakeName, !clearComments, force, includeTags, sdkPackage, ops)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force, !v1, pluginFramework, includeTags)
 	},
 }
@@ -35,4 +54,10 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginFramework, "plugin-framework", "p", false, "generate for Terraform Plugin-Framework")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&sdkPackage, "sdk-package", "", "AWS Go SDK v2 service package to generate from, defaults to the service package name (e.g., rbin)")
+	resourceCmd.Flags().StringVar(&createOperation, "create-operation", "", "generate a Plugin-Framework resource from the AWS Go SDK v2 API model, using this create operation (e.g., CreateRule)")
+	resourceCmd.Flags().StringVar(&readOperation, "read-operation", "", "read (describe) operation used with --create-operation (e.g., GetRule)")
+	resourceCmd.Flags().StringVar(&updateOperation, "update-operation", "", "optional update operation used with --create-operation (e.g., UpdateRule)")
+	resourceCmd.Flags().StringVar(&deleteOperation, "delete-operation", "", "delete operation used with --create-operation (e.g., DeleteRule)")
+	resourceCmd.Flags().StringVar(&listOperation, "list-operation", "", "optional list operation used by the sweeper, defaults to List<name>s if it exists (e.g., ListRules)")
 }
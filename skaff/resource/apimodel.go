@@ -0,0 +1,970 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+// APIModelOperations names the AWS SDK for Go v2 API operations that a resource is generated from.
+type APIModelOperations struct {
+	Create string
+	Read   string
+	Update string // Optional.
+	Delete string
+	List   string // Optional. Defaults to "List<Resource>s" if the service has that operation.
+}
+
+// apiModel is the subset of an AWS SDK for Go v2 service package's API model used to generate a resource.
+type apiModel interface {
+	Operation(name string) (*apimodel.Operation, error)
+	HasFunc(name string) bool
+	HasType(name string) bool
+}
+
+// ModelData is the part of the template data derived from the AWS SDK for Go v2 API model.
+type ModelData struct {
+	SDKPackage     string // Import path of the service package.
+	SDKPackageName string
+
+	CreateOperation string
+	ReadOperation   string
+	UpdateOperation string
+	DeleteOperation string
+
+	IDField          string // API field that identifies the resource, e.g. "Identifier".
+	IDModelField     string // Resource model field with the "id" attribute.
+	IDFromArgument   bool   // Whether the resource's ID is the value of the IDField argument.
+	ReadInputIDField string
+	ReadInputIDList  bool   // Whether the Read operation takes a list of identifiers.
+	ReadObjectExpr   string // Expression for the resource's API object in the Read operation output.
+	ReadObjectType   string
+	ReadObjectList   bool // Whether ReadObjectExpr is a list containing the resource's API object.
+	CreateObjectExpr string
+	HasClientToken   bool
+	ReadHasTags      bool
+	NotFoundError    string // Exception type in the types package.
+
+	Status *StatusData
+
+	Attributes   []*AttributeData
+	UpdatedNames []string // Model fields of updatable arguments.
+	NestedModels []*NestedModelData
+	Unsupported  []string
+	Example      []string // Terraform configuration with all required arguments.
+
+	ExampleUsesName  bool // Whether Example contains a "%[1]q" verb for a random name.
+	TestUsesAWSTypes bool // Whether ReadObjectType is declared in the service's types package.
+
+	List *ListData
+
+	Imports []string
+}
+
+// StatusData describes the resource status used by waiters.
+type StatusData struct {
+	Field    string
+	Pending  []string // Enum constants.
+	Target   []string
+	Updating []string
+	Deleting []string
+}
+
+// ListData describes the List operation used by the sweeper.
+type ListData struct {
+	Operation      string
+	Paginated      bool
+	HasNextToken   bool // Whether a non-paginated operation's output has a NextToken field.
+	ItemsField     string
+	ItemIDExpr     string
+	RequiredFields []string
+	Imports        []string
+}
+
+// AttributeData describes a schema attribute or block and its resource model field.
+type AttributeData struct {
+	Name               string // Terraform attribute name.
+	Key                string // Schema map key expression, if not the quoted name.
+	Expr               string // Complete schema expression, for standard attributes.
+	GoName             string // Model field name, the same as the API field name.
+	ModelType          string
+	SchemaType         string
+	Block              bool
+	Nested             *NestedModelData
+	CustomType         string
+	ElementType        string
+	Required           bool
+	Optional           bool
+	Computed           bool
+	RequiresReplace    bool
+	UseStateForUnknown bool
+	PlanModifierType   string // e.g. "String".
+	PlanModifierPkg    string // e.g. "stringplanmodifier".
+	ValidatorType      string // e.g. "String".
+	Validators         []string
+	Description        string
+}
+
+// NestedModelData describes the model of a nested block or nested object attribute.
+type NestedModelData struct {
+	Name       string
+	TypeName   string // API type name.
+	Attributes []*AttributeData
+	Blocks     []*AttributeData
+	Fields     []*AttributeData // Attributes and blocks, sorted by name.
+}
+
+// Argument returns "Required" or "Optional" if the attribute or block is a configurable argument,
+// or "" if it is computed-only or one of the standard attributes.
+func (a *AttributeData) Argument() string {
+	switch {
+	case a.Expr != "":
+		return ""
+	case a.Required, a.Block && contains(a.Validators, "listvalidator.IsRequired()"):
+		return "Required"
+	case a.Optional:
+		return "Optional"
+	}
+
+	return ""
+}
+
+// IDAttributeName returns the name of the attribute used to import the resource.
+func (m *ModelData) IDAttributeName() string {
+	if m.IDFromArgument {
+		return ToSnakeCase(m.IDField, "")
+	}
+
+	return names.AttrID
+}
+
+// attributeSource records the API operation structures in which a field appears.
+type attributeSource struct {
+	create, update, read *apimodel.Field
+}
+
+func (s attributeSource) field() *apimodel.Field {
+	switch {
+	case s.create != nil:
+		return s.create
+	case s.update != nil:
+		return s.update
+	default:
+		return s.read
+	}
+}
+
+type modelBuilder struct {
+	data     *ModelData
+	nested   map[string]*NestedModelData
+	resName  string
+	declared map[string]bool // Type names already declared in the service package.
+}
+
+func buildModelData(m apiModel, sdkPackage, resName string, ops APIModelOperations, tags bool, declared map[string]bool) (*ModelData, error) {
+	if ops.Create == "" || ops.Read == "" || ops.Delete == "" {
+		return nil, fmt.Errorf("create, read and delete operations are required")
+	}
+
+	create, err := m.Operation(ops.Create)
+	if err != nil {
+		return nil, err
+	}
+
+	read, err := m.Operation(ops.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	del, err := m.Operation(ops.Delete)
+	if err != nil {
+		return nil, err
+	}
+
+	var update *apimodel.Operation
+	if ops.Update != "" {
+		if update, err = m.Operation(ops.Update); err != nil {
+			return nil, err
+		}
+	}
+
+	b := &modelBuilder{
+		data: &ModelData{
+			SDKPackage:      sdkPackage,
+			SDKPackageName:  path.Base(sdkPackage),
+			CreateOperation: ops.Create,
+			ReadOperation:   ops.Read,
+			UpdateOperation: ops.Update,
+			DeleteOperation: ops.Delete,
+		},
+		nested:   make(map[string]*NestedModelData),
+		resName:  resName,
+		declared: declared,
+	}
+	data := b.data
+
+	if data.IDField = identifierField(del.Input); data.IDField == "" {
+		return nil, fmt.Errorf("determining resource identifier from %s", del.Input.Name)
+	}
+
+	if err := b.setReadObject(read); err != nil {
+		return nil, err
+	}
+	readObject := readObjectStruct(read, data)
+
+	data.CreateObjectExpr = "output"
+	if v := singleStructField(create.Output, false); v != nil {
+		data.CreateObjectExpr = "output." + v.Name
+	}
+	data.HasClientToken = create.Input.Field("ClientToken") != nil
+	data.ReadHasTags = readObject.Field("Tags") != nil
+
+	switch {
+	case m.HasType("ResourceNotFoundException"):
+		data.NotFoundError = "ResourceNotFoundException"
+	case m.HasType("NotFoundException"):
+		data.NotFoundError = "NotFoundException"
+	}
+
+	b.addAttributes(create, update, readObject, tags)
+	b.setStatus(readObject)
+
+	if ops.List == "" {
+		if v := "List" + resName + "s"; m.HasFunc("New" + v + "Paginator") {
+			ops.List = v
+		}
+	}
+	if ops.List != "" {
+		list, err := m.Operation(ops.List)
+		if err != nil {
+			return nil, err
+		}
+
+		data.List = newListData(list, data.SDKPackage, data.IDField, m.HasFunc("New"+ops.List+"Paginator"))
+	}
+
+	data.Example = exampleConfiguration(data.Attributes, "  ")
+	for _, v := range data.Example {
+		if strings.Contains(v, "%[1]q") {
+			data.ExampleUsesName = true
+		}
+	}
+	data.TestUsesAWSTypes = strings.HasPrefix(data.ReadObjectType, "awstypes.")
+	b.setImports(tags)
+
+	return data, nil
+}
+
+// identifierField returns the name of the field in the specified Delete operation input that identifies the resource.
+func identifierField(input *apimodel.Struct) string {
+	for _, v := range input.Fields {
+		if v.Required && v.Type.Kind == apimodel.KindString {
+			return v.Name
+		}
+	}
+
+	for _, v := range []string{"Id", "Arn", "Name", "Identifier"} {
+		if f := input.Field(v); f != nil && f.Type.Kind == apimodel.KindString {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// singleStructField returns the only structure (or, if list is true, list of structures) field of an operation output.
+func singleStructField(s *apimodel.Struct, list bool) *apimodel.Field {
+	var fields []*apimodel.Field
+
+	for _, v := range s.Fields {
+		switch {
+		case v.Name == "NextToken":
+			continue
+		case !list && v.Type.Kind == apimodel.KindStruct:
+		case list && v.Type.Kind == apimodel.KindList && v.Type.Elem.Kind == apimodel.KindStruct:
+		default:
+			return nil
+		}
+
+		fields = append(fields, v)
+	}
+
+	if len(fields) != 1 {
+		return nil
+	}
+
+	return fields[0]
+}
+
+func (b *modelBuilder) setReadObject(read *apimodel.Operation) error {
+	data := b.data
+
+	switch f := read.Input.Field(data.IDField); {
+	case f != nil && f.Type.Kind == apimodel.KindString:
+		data.ReadInputIDField = f.Name
+	default:
+		if f := read.Input.Field(data.IDField + "s"); f != nil && f.Type.Kind == apimodel.KindList && f.Type.Elem.Kind == apimodel.KindString {
+			data.ReadInputIDField = f.Name
+			data.ReadInputIDList = true
+			break
+		}
+
+		for _, v := range read.Input.Fields {
+			if v.Required && v.Type.Kind == apimodel.KindString {
+				data.ReadInputIDField = v.Name
+				break
+			}
+		}
+	}
+
+	if data.ReadInputIDField == "" {
+		return fmt.Errorf("determining resource identifier in %s", read.Input.Name)
+	}
+
+	switch {
+	case singleStructField(read.Output, false) != nil:
+		v := singleStructField(read.Output, false)
+		data.ReadObjectExpr = "output." + v.Name
+		data.ReadObjectType = "awstypes." + v.Type.Name
+	case singleStructField(read.Output, true) != nil:
+		v := singleStructField(read.Output, true)
+		data.ReadObjectExpr = "output." + v.Name
+		data.ReadObjectType = "awstypes." + v.Type.Elem.Name
+		data.ReadObjectList = true
+	default:
+		data.ReadObjectExpr = "output"
+		data.ReadObjectType = data.SDKPackageName + "." + read.Output.Name
+	}
+
+	return nil
+}
+
+func readObjectStruct(read *apimodel.Operation, data *ModelData) *apimodel.Struct {
+	if data.ReadObjectExpr == "output" {
+		return read.Output
+	}
+
+	f := read.Output.Field(strings.TrimPrefix(data.ReadObjectExpr, "output."))
+	if data.ReadObjectList {
+		return f.Type.Elem.Struct
+	}
+
+	return f.Type.Struct
+}
+
+// ignoredField returns whether the specified field is handled by the resource template rather than the schema.
+func ignoredField(name string) bool {
+	switch name {
+	case "ClientToken", "NextToken", "Tags":
+		return true
+	}
+
+	return false
+}
+
+func (b *modelBuilder) addAttributes(create, update *apimodel.Operation, readObject *apimodel.Struct, tags bool) {
+	data := b.data
+	sources := make(map[string]*attributeSource)
+	var fieldNames []string
+
+	add := func(s *apimodel.Struct, set func(*attributeSource, *apimodel.Field)) {
+		if s == nil {
+			return
+		}
+
+		for _, v := range s.Fields {
+			if ignoredField(v.Name) {
+				continue
+			}
+
+			source, ok := sources[v.Name]
+			if !ok {
+				source = &attributeSource{}
+				sources[v.Name] = source
+				fieldNames = append(fieldNames, v.Name)
+			}
+			set(source, v)
+		}
+	}
+	add(create.Input, func(s *attributeSource, f *apimodel.Field) { s.create = f })
+	if update != nil {
+		add(update.Input, func(s *attributeSource, f *apimodel.Field) { s.update = f })
+	}
+	add(readObject, func(s *attributeSource, f *apimodel.Field) { s.read = f })
+
+	// The resource's ID is either computed or the value of an argument.
+	data.IDModelField = data.IDField
+	idIsArgument := sources[data.IDField] != nil && sources[data.IDField].create != nil
+	if idIsArgument && ToSnakeCase(data.IDField, "") != names.AttrID {
+		data.IDFromArgument = true
+		data.IDModelField = "ID"
+	}
+
+	for _, name := range fieldNames {
+		source := sources[name]
+		if name == data.IDField && !idIsArgument {
+			continue // The "id" attribute.
+		}
+
+		attr := b.newAttribute(source.field(), name)
+		if attr == nil {
+			data.Unsupported = append(data.Unsupported, name)
+			continue
+		}
+
+		switch {
+		case source.create != nil:
+			attr.Required = source.create.Required && !attr.Block
+			attr.Optional = !attr.Required
+			attr.RequiresReplace = source.update == nil || name == data.IDField
+			if source.create.Required && attr.Block {
+				attr.Validators = append(attr.Validators, "listvalidator.IsRequired()")
+			}
+		case source.update != nil:
+			attr.Optional = true
+		default:
+			attr.Computed = true
+			attr.UseStateForUnknown = true
+		}
+
+		if attr.Optional && source.read != nil && !attr.Block {
+			attr.Computed = true
+			attr.UseStateForUnknown = true
+		}
+		if source.update != nil && name != data.IDField {
+			data.UpdatedNames = append(data.UpdatedNames, name)
+		}
+		if attr.Computed && !attr.Optional {
+			b.computedOnly(attr)
+		}
+		b.setPlanModifiers(attr)
+
+		data.Attributes = append(data.Attributes, attr)
+	}
+
+	if !idIsArgument || data.IDFromArgument {
+		data.Attributes = append(data.Attributes, &AttributeData{
+			Name:        names.AttrID,
+			Key:         "names.AttrID",
+			Expr:        "framework.IDAttribute()",
+			GoName:      data.IDModelField,
+			ModelType:   "types.String",
+			Computed:    true,
+			Description: "Identifier of the resource.",
+		})
+	}
+
+	if tags {
+		data.Attributes = append(data.Attributes,
+			&AttributeData{Name: names.AttrTags, Key: "names.AttrTags", Expr: "tftags.TagsAttribute()", GoName: "Tags", ModelType: "types.Map", Optional: true},
+			&AttributeData{Name: names.AttrTagsAll, Key: "names.AttrTagsAll", Expr: "tftags.TagsAttributeComputedOnly()", GoName: "TagsAll", ModelType: "types.Map", Computed: true},
+		)
+	}
+
+	sort.Slice(data.Attributes, func(i, j int) bool {
+		return data.Attributes[i].Name < data.Attributes[j].Name
+	})
+	sort.Slice(data.NestedModels, func(i, j int) bool {
+		return data.NestedModels[i].Name < data.NestedModels[j].Name
+	})
+}
+
+// newAttribute returns the attribute corresponding to the specified API field, or nil if its type is unsupported.
+func (b *modelBuilder) newAttribute(f *apimodel.Field, name string) *AttributeData {
+	attr := &AttributeData{
+		Name:        ToSnakeCase(name, ""),
+		GoName:      name,
+		Description: description(f.Doc),
+	}
+
+	switch t := f.Type; t.Kind {
+	case apimodel.KindBool:
+		attr.ModelType, attr.SchemaType, attr.PlanModifierType = "types.Bool", "schema.BoolAttribute", "Bool"
+	case apimodel.KindEnum:
+		attr.ModelType, attr.SchemaType, attr.PlanModifierType = "types.String", "schema.StringAttribute", "String"
+		attr.ValidatorType = "String"
+		attr.Validators = []string{fmt.Sprintf("enum.FrameworkValidate[awstypes.%s]()", t.Name)}
+	case apimodel.KindFloat:
+		attr.ModelType, attr.SchemaType, attr.PlanModifierType = "types.Float64", "schema.Float64Attribute", "Float64"
+	case apimodel.KindInt:
+		attr.ModelType, attr.SchemaType, attr.PlanModifierType = "types.Int64", "schema.Int64Attribute", "Int64"
+	case apimodel.KindString:
+		attr.ModelType, attr.SchemaType, attr.PlanModifierType = "types.String", "schema.StringAttribute", "String"
+	case apimodel.KindTimestamp:
+		attr.ModelType, attr.SchemaType, attr.PlanModifierType = "fwtypes.TimestampValue", "schema.StringAttribute", "String"
+		attr.CustomType = "fwtypes.TimestampType{}"
+	case apimodel.KindMap:
+		attr.ModelType, attr.SchemaType, attr.PlanModifierType = "types.Map", "schema.MapAttribute", "Map"
+		attr.ElementType = "types.StringType"
+	case apimodel.KindList:
+		switch elem := t.Elem; elem.Kind {
+		case apimodel.KindStruct:
+			if !b.setBlock(attr, elem) {
+				return nil
+			}
+		default:
+			elemType := elementType(elem)
+			if elemType == "" {
+				return nil
+			}
+			attr.ModelType, attr.SchemaType, attr.PlanModifierType = "types.List", "schema.ListAttribute", "List"
+			attr.ElementType = elemType
+		}
+	case apimodel.KindStruct:
+		if !b.setBlock(attr, t) {
+			return nil
+		}
+		attr.ValidatorType = "List"
+		attr.Validators = []string{"listvalidator.SizeAtMost(1)"}
+	default:
+		return nil
+	}
+
+	if attr.PlanModifierType != "" {
+		attr.PlanModifierPkg = strings.ToLower(attr.PlanModifierType) + "planmodifier"
+	}
+
+	return attr
+}
+
+func elementType(t *apimodel.Type) string {
+	switch t.Kind {
+	case apimodel.KindBool:
+		return "types.BoolType"
+	case apimodel.KindEnum, apimodel.KindString:
+		return "types.StringType"
+	case apimodel.KindFloat:
+		return "types.Float64Type"
+	case apimodel.KindInt:
+		return "types.Int64Type"
+	}
+
+	return ""
+}
+
+// setBlock makes the specified attribute a list nested block of the specified structure type.
+func (b *modelBuilder) setBlock(attr *AttributeData, t *apimodel.Type) bool {
+	nested := b.nestedModel(t)
+	if nested == nil {
+		return false
+	}
+
+	attr.Block = true
+	attr.Nested = nested
+	attr.ModelType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", nested.Name)
+	attr.SchemaType = "schema.ListNestedBlock"
+	attr.CustomType = fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", nested.Name)
+	attr.PlanModifierType = "List"
+
+	return true
+}
+
+// computedOnly converts a computed-only block to an attribute, as blocks cannot be computed.
+func (b *modelBuilder) computedOnly(attr *AttributeData) {
+	if !attr.Block {
+		return
+	}
+
+	attr.Block = false
+	attr.SchemaType = "schema.ListAttribute"
+	attr.ElementType = fmt.Sprintf("fwtypes.NewObjectTypeOf[%s](ctx)", attr.Nested.Name)
+	attr.ValidatorType = ""
+	attr.Validators = nil
+}
+
+func (b *modelBuilder) nestedModel(t *apimodel.Type) *NestedModelData {
+	if v, ok := b.nested[t.Name]; ok {
+		return v
+	}
+
+	// Nested models are named after the API type, prefixed by the resource name
+	// if another resource in the service package already declares that name.
+	nested := &NestedModelData{
+		Name:     lowerFirst(t.Name) + "Data",
+		TypeName: t.Name,
+	}
+	if b.declared[nested.Name] {
+		nested.Name = lowerFirst(b.resName) + t.Name + "Data"
+	}
+	b.nested[t.Name] = nested
+
+	for _, f := range t.Struct.Fields {
+		attr := b.newAttribute(f, f.Name)
+		if attr == nil {
+			b.data.Unsupported = append(b.data.Unsupported, t.Name+"."+f.Name)
+			continue
+		}
+
+		attr.Required = f.Required && !attr.Block
+		attr.Optional = !attr.Required
+		if f.Required && attr.Block {
+			attr.Validators = append(attr.Validators, "listvalidator.IsRequired()")
+		}
+		b.setPlanModifiers(attr)
+
+		nested.Fields = append(nested.Fields, attr)
+	}
+
+	if len(nested.Fields) == 0 {
+		delete(b.nested, t.Name)
+		return nil
+	}
+
+	sort.Slice(nested.Fields, func(i, j int) bool {
+		return nested.Fields[i].Name < nested.Fields[j].Name
+	})
+	for _, v := range nested.Fields {
+		if v.Block {
+			nested.Blocks = append(nested.Blocks, v)
+		} else {
+			nested.Attributes = append(nested.Attributes, v)
+		}
+	}
+
+	b.data.NestedModels = append(b.data.NestedModels, nested)
+
+	return nested
+}
+
+func (b *modelBuilder) setPlanModifiers(attr *AttributeData) {
+	if !attr.RequiresReplace && !attr.UseStateForUnknown {
+		attr.PlanModifierType = ""
+		attr.PlanModifierPkg = ""
+	}
+}
+
+var (
+	statusFieldNames = []string{"Status", "State"}
+
+	createdStatuses  = []string{"ACTIVE", "AVAILABLE", "COMPLETED", "CREATED", "DEPLOYED", "ENABLED", "HEALTHY", "IN_SERVICE", "READY", "RUNNING", "SUCCEEDED"}
+	pendingStatuses  = []string{"CREATE_IN_PROGRESS", "CREATING", "IN_PROGRESS", "INITIALIZING", "PENDING", "PROVISIONING", "STARTING"}
+	updatingStatuses = []string{"MODIFYING", "UPDATE_IN_PROGRESS", "UPDATING"}
+	deletingStatuses = []string{"DELETE_IN_PROGRESS", "DELETING"}
+)
+
+// setStatus determines the resource's status field and classifies its values for use by waiters.
+func (b *modelBuilder) setStatus(readObject *apimodel.Struct) {
+	var field *apimodel.Field
+
+	for _, v := range statusFieldNames {
+		if f := readObject.Field(v); f != nil && f.Type.Kind == apimodel.KindEnum {
+			field = f
+			break
+		}
+	}
+	if field == nil {
+		for _, f := range readObject.Fields {
+			if f.Type.Kind == apimodel.KindEnum && (strings.HasSuffix(f.Name, "Status") || strings.HasSuffix(f.Name, "State")) {
+				field = f
+				break
+			}
+		}
+	}
+	if field == nil {
+		return
+	}
+
+	status := &StatusData{
+		Field: field.Name,
+	}
+
+	for _, v := range field.Type.Values {
+		value := strings.ToUpper(strings.NewReplacer("-", "_", " ", "_").Replace(v.Value))
+		constant := "awstypes." + v.Name
+
+		switch {
+		case contains(createdStatuses, value):
+			status.Target = append(status.Target, constant)
+		case contains(pendingStatuses, value):
+			status.Pending = append(status.Pending, constant)
+		case contains(updatingStatuses, value):
+			status.Updating = append(status.Updating, constant)
+		case contains(deletingStatuses, value):
+			status.Deleting = append(status.Deleting, constant)
+		}
+	}
+
+	if len(status.Target) == 0 {
+		return
+	}
+
+	b.data.Status = status
+}
+
+func newListData(list *apimodel.Operation, sdkPackage, idField string, paginated bool) *ListData {
+	data := &ListData{
+		Operation: list.Name,
+		Paginated: paginated,
+	}
+
+	for _, v := range list.Input.Fields {
+		if v.Required {
+			data.RequiredFields = append(data.RequiredFields, v.Name)
+		}
+	}
+
+	for _, v := range list.Output.Fields {
+		if v.Type.Kind != apimodel.KindList {
+			continue
+		}
+
+		switch elem := v.Type.Elem; elem.Kind {
+		case apimodel.KindString:
+			data.ItemsField = v.Name
+			data.ItemIDExpr = "v"
+		case apimodel.KindStruct:
+			for _, name := range []string{idField, "Id", "Arn", "Name"} {
+				f := elem.Struct.Field(name)
+				if f == nil || f.Type.Kind != apimodel.KindString {
+					continue
+				}
+
+				data.ItemsField = v.Name
+				if f.Pointer {
+					data.ItemIDExpr = fmt.Sprintf("aws.ToString(v.%s)", f.Name)
+				} else {
+					data.ItemIDExpr = "v." + f.Name
+				}
+				break
+			}
+		}
+
+		if data.ItemsField != "" {
+			break
+		}
+	}
+
+	if data.ItemsField == "" {
+		return nil
+	}
+
+	data.HasNextToken = !paginated && list.Output.Field("NextToken") != nil && list.Input.Field("NextToken") != nil
+
+	data.Imports = []string{`"context"`, ""}
+	if strings.HasPrefix(data.ItemIDExpr, "aws.") || data.HasNextToken {
+		data.Imports = append(data.Imports, `"github.com/aws/aws-sdk-go-v2/aws"`)
+	}
+	data.Imports = append(data.Imports,
+		importLine("", sdkPackage),
+		`"github.com/hashicorp/terraform-provider-aws/internal/conns"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/sweep"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"`,
+	)
+
+	return data
+}
+
+// exampleConfiguration returns the Terraform configuration lines for all required arguments, indented by the specified prefix.
+// As in `terraform fmt` output, the equals signs of consecutive arguments are aligned.
+func exampleConfiguration(attrs []*AttributeData, indent string) []string {
+	var lines []string
+	var group []*AttributeData
+
+	flush := func() {
+		if len(group) > 0 && len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		width := 0
+		for _, v := range group {
+			if len(v.Name) > width {
+				width = len(v.Name)
+			}
+		}
+		for _, v := range group {
+			lines = append(lines, fmt.Sprintf("%s%-*s = %s", indent, width, v.Name, exampleValue(v)))
+		}
+		group = nil
+	}
+
+	for _, v := range attrs {
+		switch {
+		case v.Block && contains(v.Validators, "listvalidator.IsRequired()"):
+			flush()
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, fmt.Sprintf("%s%s {", indent, v.Name))
+			lines = append(lines, exampleConfiguration(v.Nested.Fields, indent+"  ")...)
+			lines = append(lines, indent+"}")
+		case v.Required:
+			group = append(group, v)
+		}
+	}
+	flush()
+
+	return lines
+}
+
+func exampleValue(attr *AttributeData) string {
+	switch attr.ModelType {
+	case "types.Bool":
+		return "true"
+	case "types.Float64", "types.Int64":
+		return "1"
+	case "types.List":
+		return `["example"]`
+	case "types.Map":
+		return `{ key = "value" }`
+	}
+
+	if strings.HasPrefix(attr.ValidatorType, "String") && len(attr.Validators) > 0 {
+		return `"TODO"` // Enum value.
+	}
+
+	if strings.HasSuffix(attr.Name, "name") {
+		return "%[1]q"
+	}
+
+	return `"example"`
+}
+
+// description returns the first sentence of an API field's documentation.
+func description(doc string) string {
+	doc = strings.Join(strings.Fields(doc), " ")
+
+	if i := strings.Index(doc, ". "); i >= 0 {
+		doc = doc[:i+1]
+	}
+
+	return doc
+}
+
+func (b *modelBuilder) setImports(tags bool) {
+	data := b.data
+	usesAWSTypes := data.NotFoundError != "" || data.Status != nil || strings.HasPrefix(data.ReadObjectType, "awstypes.")
+	usesEnum := data.Status != nil
+	usesFWTypes := false
+	usesListValidator := false
+	planModifierPkgs := make(map[string]bool)
+
+	var walk func([]*AttributeData)
+	walk = func(attrs []*AttributeData) {
+		for _, v := range attrs {
+			if strings.HasPrefix(v.CustomType, "fwtypes.") || strings.HasPrefix(v.ModelType, "fwtypes.") {
+				usesFWTypes = true
+			}
+			for _, validator := range v.Validators {
+				switch {
+				case strings.HasPrefix(validator, "enum."):
+					usesEnum, usesAWSTypes = true, true
+				case strings.HasPrefix(validator, "listvalidator."):
+					usesListValidator = true
+				}
+			}
+			if v.PlanModifierPkg != "" {
+				planModifierPkgs[v.PlanModifierPkg] = true
+			}
+			if v.Nested != nil && v.Block {
+				walk(v.Nested.Fields)
+			}
+		}
+	}
+	walk(data.Attributes)
+
+	imports := []string{`"context"`, `"time"`, ""}
+	if data.HasClientToken || !data.ReadInputIDList {
+		imports = append(imports, `"github.com/aws/aws-sdk-go-v2/aws"`)
+	}
+	imports = append(imports, importLine("", data.SDKPackage))
+	if usesAWSTypes {
+		imports = append(imports, importLine("awstypes", data.SDKPackage+"/types"))
+	}
+	imports = append(imports, `"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"`)
+	if usesListValidator {
+		imports = append(imports, `"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"`)
+	}
+	imports = append(imports,
+		`"github.com/hashicorp/terraform-plugin-framework/resource"`,
+		`"github.com/hashicorp/terraform-plugin-framework/resource/schema"`,
+	)
+	if len(planModifierPkgs) > 0 {
+		imports = append(imports, `"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"`)
+		var pkgs []string
+		for k := range planModifierPkgs {
+			pkgs = append(pkgs, importLine("", "github.com/hashicorp/terraform-plugin-framework/resource/schema/"+k))
+		}
+		sort.Strings(pkgs)
+		imports = append(imports, pkgs...)
+	}
+	if hasValidators(data.Attributes) {
+		imports = append(imports, `"github.com/hashicorp/terraform-plugin-framework/schema/validator"`)
+	}
+	imports = append(imports, `"github.com/hashicorp/terraform-plugin-framework/types"`)
+	if data.HasClientToken {
+		imports = append(imports, `"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"`)
+	}
+	if data.NotFoundError != "" || data.Status != nil {
+		imports = append(imports, `"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"`)
+	}
+	imports = append(imports, `"github.com/hashicorp/terraform-provider-aws/internal/create"`)
+	if usesEnum {
+		imports = append(imports, `"github.com/hashicorp/terraform-provider-aws/internal/enum"`)
+	}
+	if data.NotFoundError != "" {
+		imports = append(imports, `"github.com/hashicorp/terraform-provider-aws/internal/errs"`)
+	}
+	imports = append(imports,
+		`"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/framework"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"`,
+	)
+	if usesFWTypes {
+		imports = append(imports, importLine("fwtypes", "github.com/hashicorp/terraform-provider-aws/internal/framework/types"))
+	}
+	if tags {
+		imports = append(imports, importLine("tftags", "github.com/hashicorp/terraform-provider-aws/internal/tags"))
+	}
+	imports = append(imports,
+		`"github.com/hashicorp/terraform-provider-aws/internal/tfresource"`,
+		`"github.com/hashicorp/terraform-provider-aws/names"`,
+	)
+
+	data.Imports = imports
+}
+
+// importLine returns an import declaration line for the specified package.
+func importLine(alias, path string) string {
+	if alias == "" {
+		return fmt.Sprintf("%q", path)
+	}
+
+	return fmt.Sprintf("%s %q", alias, path)
+}
+
+func hasValidators(attrs []*AttributeData) bool {
+	for _, v := range attrs {
+		if len(v.Validators) > 0 {
+			return true
+		}
+		if v.Nested != nil && v.Block && hasValidators(v.Nested.Fields) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
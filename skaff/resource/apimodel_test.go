@@ -0,0 +1,427 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"go/format"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+type testAPIModel struct {
+	operations map[string]*apimodel.Operation
+	funcs      []string
+	types      []string
+}
+
+func (m *testAPIModel) Operation(name string) (*apimodel.Operation, error) {
+	if v, ok := m.operations[name]; ok {
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("operation %s not found", name)
+}
+
+func (m *testAPIModel) HasFunc(name string) bool {
+	return contains(m.funcs, name)
+}
+
+func (m *testAPIModel) HasType(name string) bool {
+	return contains(m.types, name)
+}
+
+func newTestAPIModel() *testAPIModel {
+	stringType := &apimodel.Type{Kind: apimodel.KindString}
+	statusType := &apimodel.Type{
+		Kind: apimodel.KindEnum,
+		Name: "WidgetStatus",
+		Values: []apimodel.EnumValue{
+			{Name: "WidgetStatusActive", Value: "ACTIVE"},
+			{Name: "WidgetStatusCreating", Value: "CREATING"},
+			{Name: "WidgetStatusDeleting", Value: "DELETING"},
+		},
+	}
+	configType := &apimodel.Type{
+		Kind: apimodel.KindStruct,
+		Name: "Config",
+		Struct: &apimodel.Struct{
+			Name: "Config",
+			Fields: []*apimodel.Field{
+				{Name: "Enabled", Required: true, Type: &apimodel.Type{Kind: apimodel.KindBool}},
+			},
+		},
+	}
+	widgetType := &apimodel.Type{
+		Kind: apimodel.KindStruct,
+		Name: "Widget",
+		Struct: &apimodel.Struct{
+			Name: "Widget",
+			Fields: []*apimodel.Field{
+				{Name: "Arn", Doc: "The ARN of the widget. Long description.", Type: stringType},
+				{Name: "Config", Type: configType},
+				{Name: "CreatedAt", Type: &apimodel.Type{Kind: apimodel.KindTimestamp}},
+				{Name: "Name", Pointer: true, Type: stringType},
+				{Name: "Size", Type: &apimodel.Type{Kind: apimodel.KindInt}},
+				{Name: "Status", Type: statusType},
+			},
+		},
+	}
+	nameField := &apimodel.Field{Name: "Name", Doc: "The name of the widget.", Required: true, Type: stringType}
+	widgetOutput := &apimodel.Struct{
+		Fields: []*apimodel.Field{
+			{Name: "Widget", Type: widgetType},
+		},
+	}
+
+	return &testAPIModel{
+		operations: map[string]*apimodel.Operation{
+			"CreateWidget": {
+				Name: "CreateWidget",
+				Input: &apimodel.Struct{
+					Name: "CreateWidgetInput",
+					Fields: []*apimodel.Field{
+						nameField,
+						{Name: "ClientToken", Type: stringType},
+						{Name: "Config", Type: configType},
+						{Name: "Data", Type: &apimodel.Type{Kind: apimodel.KindUnsupported}},
+						{Name: "Size", Type: &apimodel.Type{Kind: apimodel.KindInt}},
+						{Name: "Tags", Type: &apimodel.Type{Kind: apimodel.KindMap, Elem: stringType}},
+					},
+				},
+				Output: widgetOutput,
+			},
+			"GetWidget": {
+				Name:   "GetWidget",
+				Input:  &apimodel.Struct{Name: "GetWidgetInput", Fields: []*apimodel.Field{nameField}},
+				Output: widgetOutput,
+			},
+			"UpdateWidget": {
+				Name: "UpdateWidget",
+				Input: &apimodel.Struct{
+					Name: "UpdateWidgetInput",
+					Fields: []*apimodel.Field{
+						nameField,
+						{Name: "Size", Type: &apimodel.Type{Kind: apimodel.KindInt}},
+					},
+				},
+				Output: &apimodel.Struct{},
+			},
+			"DeleteWidget": {
+				Name:   "DeleteWidget",
+				Input:  &apimodel.Struct{Name: "DeleteWidgetInput", Fields: []*apimodel.Field{nameField}},
+				Output: &apimodel.Struct{},
+			},
+			"ListWidgets": {
+				Name: "ListWidgets",
+				Input: &apimodel.Struct{
+					Name: "ListWidgetsInput",
+					Fields: []*apimodel.Field{
+						{Name: "NextToken", Type: stringType},
+					},
+				},
+				Output: &apimodel.Struct{
+					Fields: []*apimodel.Field{
+						{Name: "NextToken", Type: stringType},
+						{Name: "Widgets", Type: &apimodel.Type{Kind: apimodel.KindList, Elem: widgetType}},
+					},
+				},
+			},
+		},
+		funcs: []string{"NewListWidgetsPaginator"},
+		types: []string{"ResourceNotFoundException"},
+	}
+}
+
+var testAPIModelOperations = APIModelOperations{
+	Create: "CreateWidget",
+	Read:   "GetWidget",
+	Update: "UpdateWidget",
+	Delete: "DeleteWidget",
+}
+
+func TestBuildModelData(t *testing.T) {
+	t.Parallel()
+
+	data, err := buildModelData(newTestAPIModel(), "github.com/aws/aws-sdk-go-v2/service/widgets", "Widget", testAPIModelOperations, true, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := data.SDKPackageName, "widgets"; got != expected {
+		t.Errorf("SDKPackageName: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.IDField, "Name"; got != expected {
+		t.Errorf("IDField: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.IDModelField, "ID"; got != expected {
+		t.Errorf("IDModelField: got %s, expected %s", got, expected)
+	}
+	if !data.IDFromArgument {
+		t.Errorf("IDFromArgument: got false, expected true")
+	}
+	if got, expected := data.IDAttributeName(), "name"; got != expected {
+		t.Errorf("IDAttributeName: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.ReadObjectExpr, "output.Widget"; got != expected {
+		t.Errorf("ReadObjectExpr: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.ReadObjectType, "awstypes.Widget"; got != expected {
+		t.Errorf("ReadObjectType: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.CreateObjectExpr, "output.Widget"; got != expected {
+		t.Errorf("CreateObjectExpr: got %s, expected %s", got, expected)
+	}
+	if !data.HasClientToken {
+		t.Errorf("HasClientToken: got false, expected true")
+	}
+	if got, expected := data.NotFoundError, "ResourceNotFoundException"; got != expected {
+		t.Errorf("NotFoundError: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.Unsupported, []string{"Data"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Unsupported: got %v, expected %v", got, expected)
+	}
+	if got, expected := data.UpdatedNames, []string{"Size"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("UpdatedNames: got %v, expected %v", got, expected)
+	}
+
+	expectedStatus := &StatusData{
+		Field:    "Status",
+		Pending:  []string{"awstypes.WidgetStatusCreating"},
+		Target:   []string{"awstypes.WidgetStatusActive"},
+		Deleting: []string{"awstypes.WidgetStatusDeleting"},
+	}
+	if got := data.Status; !reflect.DeepEqual(got, expectedStatus) {
+		t.Errorf("Status: got %+v, expected %+v", got, expectedStatus)
+	}
+
+	if data.List == nil {
+		t.Fatalf("List: got nil")
+	}
+	if got, expected := data.List.Operation, "ListWidgets"; got != expected {
+		t.Errorf("List.Operation: got %s, expected %s", got, expected)
+	}
+	if !data.List.Paginated {
+		t.Errorf("List.Paginated: got false, expected true")
+	}
+	if got, expected := data.List.ItemsField, "Widgets"; got != expected {
+		t.Errorf("List.ItemsField: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.List.ItemIDExpr, "aws.ToString(v.Name)"; got != expected {
+		t.Errorf("List.ItemIDExpr: got %s, expected %s", got, expected)
+	}
+
+	attributes := make(map[string]*AttributeData)
+	var attributeNames []string
+	for _, v := range data.Attributes {
+		attributes[v.Name] = v
+		attributeNames = append(attributeNames, v.Name)
+	}
+
+	expectedNames := []string{"arn", "config", "created_at", "id", "name", "size", "status", "tags", "tags_all"}
+	if !reflect.DeepEqual(attributeNames, expectedNames) {
+		t.Fatalf("Attributes: got %v, expected %v", attributeNames, expectedNames)
+	}
+
+	testCases := []struct {
+		TestName string
+		Name     string
+		Argument string
+		Computed bool
+		Replace  bool
+	}{
+		{
+			TestName: "computed",
+			Name:     "arn",
+			Computed: true,
+		},
+		{
+			TestName: "block",
+			Name:     "config",
+			Argument: "Optional",
+			Replace:  true,
+		},
+		{
+			TestName: "identifier argument",
+			Name:     "name",
+			Argument: "Required",
+			Replace:  true,
+		},
+		{
+			TestName: "updatable argument",
+			Name:     "size",
+			Argument: "Optional",
+			Computed: true,
+		},
+		{
+			TestName: "standard attribute",
+			Name:     "id",
+			Computed: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			attr := attributes[testCase.Name]
+
+			if got := attr.Argument(); got != testCase.Argument {
+				t.Errorf("Argument: got %q, expected %q", got, testCase.Argument)
+			}
+			if got := attr.Computed; got != testCase.Computed {
+				t.Errorf("Computed: got %t, expected %t", got, testCase.Computed)
+			}
+			if got := attr.RequiresReplace; got != testCase.Replace {
+				t.Errorf("RequiresReplace: got %t, expected %t", got, testCase.Replace)
+			}
+		})
+	}
+
+	if got, expected := attributes["arn"].Description, "The ARN of the widget."; got != expected {
+		t.Errorf("Description: got %q, expected %q", got, expected)
+	}
+	if got, expected := attributes["created_at"].CustomType, "fwtypes.TimestampType{}"; got != expected {
+		t.Errorf("CustomType: got %q, expected %q", got, expected)
+	}
+
+	expectedExample := []string{`  name = %[1]q`}
+	if got := data.Example; !reflect.DeepEqual(got, expectedExample) {
+		t.Errorf("Example: got %q, expected %q", got, expectedExample)
+	}
+	if !data.ExampleUsesName {
+		t.Errorf("ExampleUsesName: got false, expected true")
+	}
+}
+
+func TestBuildModelDataDeclaredNestedModel(t *testing.T) {
+	t.Parallel()
+
+	declared := map[string]bool{"configData": true}
+	data, err := buildModelData(newTestAPIModel(), "github.com/aws/aws-sdk-go-v2/service/widgets", "Widget", testAPIModelOperations, false, declared)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+	for _, v := range data.NestedModels {
+		got = append(got, v.Name)
+	}
+
+	if expected := []string{"widgetConfigData"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("NestedModels: got %v, expected %v", got, expected)
+	}
+}
+
+func TestBuildModelDataMissingOperation(t *testing.T) {
+	t.Parallel()
+
+	ops := testAPIModelOperations
+	ops.Read = "DescribeWidget"
+
+	if _, err := buildModelData(newTestAPIModel(), "github.com/aws/aws-sdk-go-v2/service/widgets", "Widget", ops, false, nil); err == nil {
+		t.Errorf("expected error, got none")
+	}
+}
+
+func TestAPIModelTemplates(t *testing.T) {
+	t.Parallel()
+
+	for _, tags := range []bool{false, true} {
+		tags := tags
+		t.Run(fmt.Sprintf("tags=%t", tags), func(t *testing.T) {
+			t.Parallel()
+
+			data, err := buildModelData(newTestAPIModel(), "github.com/aws/aws-sdk-go-v2/service/widgets", "Widget", testAPIModelOperations, tags, nil)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			td := TemplateData{
+				Resource:                "Widget",
+				ResourceLower:           "widget",
+				ResourceSnake:           "widget",
+				HumanFriendlyService:    "Widgets",
+				IncludeComments:         true,
+				IncludeTags:             tags,
+				ServicePackage:          "widgets",
+				Service:                 "Widgets",
+				ServiceLower:            "widgets",
+				HumanResourceName:       "Widget",
+				ProviderResourceName:    "aws_widgets_widget",
+				Model:                   data,
+				TagsIdentifierAttribute: "arn",
+				NewFile:                 true,
+			}
+
+			for name, tmpl := range map[string]string{
+				"resource": resourceAPIModelTmpl,
+				"test":     resourceTestAPIModelTmpl,
+				"sweep":    sweepAPIModelTmpl,
+			} {
+				contents, err := executeTemplate(name, tmpl, td)
+
+				if err != nil {
+					t.Fatalf("executing %s template: %s", name, err)
+				}
+
+				if _, err := format.Source(contents); err != nil {
+					t.Errorf("formatting %s template: %s\n%s", name, err, contents)
+				}
+			}
+
+			contents, err := executeTemplate("webdoc", websiteAPIModelTmpl, td)
+
+			if err != nil {
+				t.Fatalf("executing webdoc template: %s", err)
+			}
+
+			for _, v := range []string{"* `name` - (Required) The name of the widget.", "### `config` Block", "* `enabled` - (Required)"} {
+				if !strings.Contains(string(contents), v) {
+					t.Errorf("webdoc template: %q not found in\n%s", v, contents)
+				}
+			}
+		})
+	}
+}
+
+func TestAddImports(t *testing.T) {
+	t.Parallel()
+
+	src := `package widgets
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+`
+	expected := `package widgets
+
+import (
+	"time"
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/aws/aws-sdk-go-v2/service/widgets"
+)
+`
+
+	got, err := addImports(src, []string{`"context"`, `"time"`, "", `"github.com/aws/aws-sdk-go-v2/service/widgets"`, `"github.com/hashicorp/terraform-provider-aws/internal/sweep"`})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("got\n%s\nexpected\n%s", got, expected)
+	}
+}
@@ -8,14 +8,20 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"text/template"
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
 )
 
 //go:embed resource.tmpl
@@ -30,6 +36,18 @@ var resourceTestTmpl string
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
+//go:embed resourceapimodel.tmpl
+var resourceAPIModelTmpl string
+
+//go:embed resourcetestapimodel.tmpl
+var resourceTestAPIModelTmpl string
+
+//go:embed sweepapimodel.tmpl
+var sweepAPIModelTmpl string
+
+//go:embed websitedocapimodel.tmpl
+var websiteAPIModelTmpl string
+
 type TemplateData struct {
 	Resource             string
 	ResourceLower        string
@@ -45,6 +63,11 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+
+	// Used when generating from the AWS SDK for Go v2 API model.
+	Model                   *ModelData
+	TagsIdentifierAttribute string
+	NewFile                 bool
 }
 
 func ToSnakeCase(upper string, snakeName string) string {
@@ -72,43 +95,147 @@ func ProviderResourceName(servicePackage, snakeName string) string {
 }
 
 func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool) error {
-	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	templateData, err := newTemplateData(resName, snakeName, comments, tags)
+	if err != nil {
+		return err
+	}
+
+	templateData.AWSGoSDKV2 = v2
+	templateData.PluginFramework = pluginFramework
+
+	tmpl := resourceTmpl
+	if pluginFramework {
+		tmpl = resourceFrameworkTmpl
+	}
+	f := fmt.Sprintf("%s.go", templateData.ResourceSnake)
+	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", templateData.ResourceSnake)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", templateData.ServicePackage, templateData.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+// CreateFromAPIModel generates a Terraform Plugin Framework resource from the API model of the specified
+// AWS SDK for Go v2 service package. The schema, model, finder, waiters, sweeper, acceptance tests and
+// website documentation are derived from the input and output structures of the specified operations.
+// sdkPackage defaults to the name of the service package.
+func CreateFromAPIModel(resName, snakeName string, comments, force, tags bool, sdkPackage string, ops APIModelOperations) error {
+	templateData, err := newTemplateData(resName, snakeName, comments, tags)
+	if err != nil {
+		return err
+	}
+
+	templateData.AWSGoSDKV2 = true
+	templateData.PluginFramework = true
+
+	if sdkPackage == "" {
+		sdkPackage = templateData.ServicePackage
+	}
+
+	wd, err := os.Getwd()
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
 	}
 
+	m, err := apimodel.Load(wd, sdkPackage)
+	if err != nil {
+		return err
+	}
+
+	f := fmt.Sprintf("%s.go", templateData.ResourceSnake)
+	declared, err := declaredTypes(wd, f)
+	if err != nil {
+		return err
+	}
+
+	if templateData.Model, err = buildModelData(m, m.Path, resName, ops, tags, declared); err != nil {
+		return fmt.Errorf("building resource model from AWS SDK for Go v2 package (%s): %w", m.Path, err)
+	}
+
+	templateData.TagsIdentifierAttribute = names.AttrID
+	for _, v := range templateData.Model.Attributes {
+		if v.Name == names.AttrARN {
+			templateData.TagsIdentifierAttribute = names.AttrARN
+		}
+	}
+
+	if err = writeGoTemplate("newres", f, resourceAPIModelTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", templateData.ResourceSnake)
+	if err = writeGoTemplate("restest", tf, resourceTestAPIModelTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	if templateData.Model.List != nil {
+		if err = writeSweeper(templateData); err != nil {
+			return fmt.Errorf("writing resource sweeper template: %w", err)
+		}
+	}
+
+	if err = writeTestExports(templateData); err != nil {
+		return fmt.Errorf("writing resource test exports: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", templateData.ServicePackage, templateData.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteAPIModelTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func newTemplateData(resName, snakeName string, comments, tags bool) (TemplateData, error) {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return TemplateData{}, fmt.Errorf("error reading working directory: %s", err)
+	}
+
 	servicePackage := filepath.Base(wd)
 
 	if resName == "" {
-		return fmt.Errorf("error checking: no name given")
+		return TemplateData{}, fmt.Errorf("error checking: no name given")
 	}
 
 	if resName == strings.ToLower(resName) {
-		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+		return TemplateData{}, fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
 	}
 
 	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
-		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+		return TemplateData{}, fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
 	snakeName = ToSnakeCase(resName, snakeName)
 
 	s, err := names.ProviderNameUpper(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting service connection name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting service connection name: %w", err)
 	}
 
 	sn, err := names.FullHumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting AWS service name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting AWS service name: %w", err)
 	}
 
 	hf, err := names.HumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting human-friendly name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting human-friendly name: %w", err)
 	}
 
-	templateData := TemplateData{
+	return TemplateData{
 		Resource:             resName,
 		ResourceLower:        strings.ToLower(resName),
 		ResourceSnake:        snakeName,
@@ -119,33 +246,164 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 		Service:              s,
 		ServiceLower:         strings.ToLower(s),
 		AWSServiceName:       sn,
-		AWSGoSDKV2:           v2,
-		PluginFramework:      pluginFramework,
 		HumanResourceName:    HumanResName(resName),
 		ProviderResourceName: ProviderResourceName(servicePackage, snakeName),
+	}, nil
+}
+
+// declaredTypes returns the names of the types declared in the Go files in the specified directory,
+// excluding the specified file.
+func declaredTypes(dir, exclude string) (map[string]bool, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
 	}
 
-	tmpl := resourceTmpl
-	if pluginFramework {
-		tmpl = resourceFrameworkTmpl
+	declared := make(map[string]bool)
+	fset := token.NewFileSet()
+
+	for _, filename := range filenames {
+		if filepath.Base(filename) == exclude {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing file (%s): %s", filename, err)
+		}
+
+		for _, decl := range file.Decls {
+			if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.TYPE {
+				for _, spec := range decl.Specs {
+					declared[spec.(*ast.TypeSpec).Name.Name] = true
+				}
+			}
+		}
 	}
-	f := fmt.Sprintf("%s.go", snakeName)
-	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource template: %w", err)
+
+	return declared, nil
+}
+
+// writeSweeper writes the resource's sweeper lister to sweep.go, creating the file if necessary.
+func writeSweeper(td TemplateData) error {
+	filename := "sweep.go"
+
+	existing, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		td.NewFile = true
+
+		return writeGoTemplate("sweep", filename, sweepAPIModelTmpl, false, td)
+	}
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
 	}
 
-	tf := fmt.Sprintf("%s_test.go", snakeName)
-	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource test template: %w", err)
+	src := string(existing)
+	if strings.Contains(src, fmt.Sprintf("func listSweepable%ss(", td.Resource)) {
+		return nil // Regenerating with force.
 	}
 
-	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
-	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource website doc template: %w", err)
+	lister, err := executeTemplate("sweep", sweepAPIModelTmpl, td)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	if src, err = addImports(src, td.Model.List.Imports); err != nil {
+		return fmt.Errorf("adding imports to file (%s): %w", filename, err)
+	}
+
+	return writeFile(filename, []byte(src+"\n"+string(lister)), true, true)
+}
+
+// addImports adds the missing import lines to the parenthesized import declaration of the specified Go source.
+// Standard library packages are added to the first import group and all others to the last.
+func addImports(src string, imports []string) (string, error) {
+	start := strings.Index(src, "\nimport (\n")
+	if start < 0 {
+		return "", fmt.Errorf("no import declaration found")
+	}
+	start += len("\nimport (\n")
+
+	end := strings.Index(src[start:], "\n)\n")
+	if end < 0 {
+		return "", fmt.Errorf("no import declaration found")
+	}
+	end += start
+
+	var stdlib, other []string
+	for _, v := range imports {
+		if v == "" || strings.Contains(src[start:end], v) {
+			continue
+		}
+
+		if path := strings.Trim(v, `"`); !strings.Contains(strings.Split(path, "/")[0], ".") {
+			stdlib = append(stdlib, "\t"+v+"\n")
+		} else {
+			other = append(other, "\n\t"+v)
+		}
+	}
+
+	return src[:start] + strings.Join(stdlib, "") + src[start:end] + strings.Join(other, "") + src[end:], nil
+}
+
+// writeTestExports exports the resource's factory and finder for use in tests, creating exports_test.go if necessary.
+func writeTestExports(td TemplateData) error {
+	filename := "exports_test.go"
+	exports := []string{
+		fmt.Sprintf("Find%[1]sByID = find%[1]sByID", td.Resource),
+		fmt.Sprintf("Resource%[1]s = newResource%[1]s", td.Resource),
+	}
+
+	existing, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		src := fmt.Sprintf(`// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package %s
+
+// Exports for use in tests only.
+var (
+	%s
+)
+`, td.ServicePackage, strings.Join(exports, "\n\t"))
+
+		return writeFile(filename, []byte(src), false, true)
+	}
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	src := string(existing)
+	start := strings.Index(src, "\nvar (\n")
+	if start < 0 {
+		return fmt.Errorf("no var declaration found in file (%s)", filename)
+	}
+	start += len("\nvar (\n")
+
+	end := strings.Index(src[start:], "\n)\n")
+	if end < 0 {
+		return fmt.Errorf("no var declaration found in file (%s)", filename)
+	}
+	end += start
+
+	lines := strings.Split(src[start:end], "\n")
+	for _, v := range exports {
+		name := strings.Fields(v)[0]
+		found := false
+		for _, line := range lines {
+			if fields := strings.Fields(line); len(fields) > 0 && fields[0] == name {
+				found = true
+			}
+		}
+		if !found {
+			lines = append(lines, "\t"+v)
+		}
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return strings.TrimSpace(lines[i]) < strings.TrimSpace(lines[j])
+	})
+
+	return writeFile(filename, []byte(src[:start]+strings.Join(lines, "\n")+src[end:]), true, true)
 }
 
 func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
@@ -153,29 +411,62 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
 	}
 
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	contents, err := executeTemplate(templateName, tmpl, td)
 	if err != nil {
-		return fmt.Errorf("error opening file (%s): %s", filename, err)
+		return err
+	}
+
+	return writeFile(filename, contents, true, false)
+}
+
+// writeGoTemplate is like writeTemplate, but formats the generated Go source.
+func writeGoTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
 	}
 
+	contents, err := executeTemplate(templateName, tmpl, td)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(filename, contents, true, true)
+}
+
+func executeTemplate(templateName, tmpl string, td TemplateData) ([]byte, error) {
 	tplate, err := template.New(templateName).Parse(tmpl)
 	if err != nil {
-		return fmt.Errorf("error parsing template: %s", err)
+		return nil, fmt.Errorf("error parsing template: %s", err)
 	}
 
 	var buffer bytes.Buffer
 	err = tplate.Execute(&buffer, td)
 	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
+		return nil, fmt.Errorf("error executing template: %s", err)
 	}
 
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
+	return buffer.Bytes(), nil
+}
+
+func writeFile(filename string, contents []byte, force, formatSource bool) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	if formatSource {
+		formatted, err := format.Source(contents)
+		if err != nil {
+			return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+		}
+		contents = formatted
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
 
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}